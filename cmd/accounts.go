@@ -0,0 +1,125 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/awsutil"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/metrics"
+	"github.com/rebuy-de/aws-nuke/pkg/notify"
+	log "github.com/sirupsen/logrus"
+)
+
+// NukeAccounts nukes every account, which is reachable by assuming one of the
+// given roles. Without any role, it only nukes the account of the given
+// credentials. A failure in one account doesn't prevent nuking the others,
+// but results in an error after all accounts got processed.
+func NukeAccounts(params NukeParameters, creds awsutil.Credentials, config *config.Nuke, roleArns []string) error {
+	if len(roleArns) == 0 {
+		roleArns = []string{creds.AssumeRoleArn}
+	}
+
+	accountCreds := make([]awsutil.Credentials, len(roleArns))
+	for i, roleArn := range roleArns {
+		accountCreds[i] = creds
+		accountCreds[i].AssumeRoleArn = roleArn
+
+		err := accountCreds[i].Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	var m *metrics.Metrics
+	if params.MetricsAddr != "" {
+		m = metrics.New()
+		shutdown := m.Serve(params.MetricsAddr)
+		defer shutdown()
+
+		for i := range accountCreds {
+			accountCreds[i].OnRequestError = m.IncAPIErrors
+		}
+	}
+
+	var notifier *notify.Notifier
+	if params.NotifyWebhook != "" {
+		notifier = notify.New(params.NotifyWebhook, params.NotifyFormat)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		// Restore the default behaviour after the first signal, so a second
+		// one kills the process immediately.
+		<-ctx.Done()
+		stop()
+	}()
+
+	var (
+		items  Queue
+		failed []string
+	)
+
+	for _, c := range accountCreds {
+		if ctx.Err() != nil {
+			return ErrInterrupted
+		}
+
+		name := c.AssumeRoleArn
+		if name == "" {
+			name = "default credentials"
+		}
+
+		start := time.Now()
+
+		account, err := awsutil.NewAccount(c, config.CustomEndpoints)
+		if err != nil {
+			log.Errorf("Failed to access account of %s: %v", name, err)
+			failed = append(failed, name)
+			sendNotification(notifier, notify.Summary{
+				AccountID: name,
+				DryRun:    !params.NoDryRun,
+				Error:     err.Error(),
+				Duration:  time.Since(start),
+			})
+			continue
+		}
+
+		accountParams := params
+		if len(accountCreds) > 1 {
+			accountParams = params.ForAccount(account.ID())
+		}
+
+		n := NewNuke(accountParams, *account)
+		n.Config = config
+		n.Metrics = m
+
+		err = n.Run(ctx)
+		items = append(items, n.items...)
+		sendNotification(notifier, n.Summary(err, time.Since(start)))
+		if err == ErrInterrupted {
+			return err
+		}
+		if err != nil {
+			log.Errorf("Failed to nuke account %s: %v", account.ID(), err)
+			failed = append(failed, account.ID())
+		}
+	}
+
+	if len(accountCreds) > 1 {
+		fmt.Fprintf(textOutput(params), "Nuked %d accounts: %d failed, %d filtered, %d removed by dependencies, %d finished.\n\n",
+			len(accountCreds), items.Count(ItemStateFailed), items.Count(ItemStateFiltered),
+			items.Count(ItemStateRemovedByDependency), items.Count(ItemStateFinished))
+	}
+
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to nuke %d of %d accounts: %v", len(failed), len(accountCreds), failed)
+	}
+
+	return nil
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/resources"
+)
+
+// ListCache stores the results of listing a resource type in a region, so
+// multiple items of the same type don't need to list them again. Results are
+// shared by all items within a pass over the queue and are reused in later
+// passes until they are older than the TTL or get invalidated. It is safe for
+// concurrent use.
+type ListCache struct {
+	lock    sync.Mutex
+	ttl     time.Duration
+	pass    int
+	reused  bool
+	entries map[string]map[string]*listCacheEntry
+
+	// list lists the resources of the item type. It can be replaced in tests.
+	list func(*Item) ([]resources.Resource, error)
+}
+
+type listCacheEntry struct {
+	once      sync.Once
+	resources []resources.Resource
+	err       error
+
+	// pass, listed and invalid are guarded by the lock of the cache.
+	pass    int
+	listed  time.Time
+	invalid bool
+}
+
+func NewListCache(ttl time.Duration) *ListCache {
+	return &ListCache{
+		ttl:     ttl,
+		entries: make(map[string]map[string]*listCacheEntry),
+		list:    (*Item).List,
+	}
+}
+
+// StartPass marks the beginning of a new pass over the queue. Entries of
+// previous passes are only used, if they are younger than the TTL.
+func (c *ListCache) StartPass() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.pass++
+	c.reused = false
+}
+
+// Reused returns true, if the current pass used a listing of a previous pass.
+// Such a pass might not see the resources, which got removed in the meantime.
+func (c *ListCache) Reused() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.reused
+}
+
+// Invalidate prevents the cached resources of the type in the region from
+// being used in later passes. It must be called after the removal of such a
+// resource got triggered, so waiting for it sees the actual remaining
+// resources. The current pass still uses the entry, so many removals of the
+// same type do not cause a listing each.
+func (c *ListCache) Invalidate(region, resourceType string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	entry, ok := c.entries[region][resourceType]
+	if ok {
+		entry.invalid = true
+	}
+}
+
+// List returns all resources of the same type and region like the given item.
+// Concurrent calls for the same type and region only trigger a single listing.
+func (c *ListCache) List(item *Item) ([]resources.Resource, error) {
+	entry := c.entry(item.Region.Name, item.Type)
+	entry.once.Do(func() {
+		entry.resources, entry.err = c.list(item)
+
+		c.lock.Lock()
+		entry.listed = time.Now()
+		c.lock.Unlock()
+	})
+	return entry.resources, entry.err
+}
+
+func (c *ListCache) entry(region, resourceType string) *listCacheEntry {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	_, ok := c.entries[region]
+	if !ok {
+		c.entries[region] = map[string]*listCacheEntry{}
+	}
+
+	entry, ok := c.entries[region][resourceType]
+	if !ok || c.expired(entry) {
+		entry = &listCacheEntry{pass: c.pass}
+		c.entries[region][resourceType] = entry
+	}
+	if entry.pass != c.pass {
+		c.reused = true
+	}
+
+	return entry
+}
+
+// expired returns true, if the entry is from a previous pass and either
+// failed, got invalidated or is older than the TTL. Entries, which are still
+// being listed, never expire.
+func (c *ListCache) expired(entry *listCacheEntry) bool {
+	if entry.pass == c.pass || entry.listed.IsZero() {
+		return false
+	}
+
+	if entry.err != nil || entry.invalid {
+		return true
+	}
+
+	return time.Since(entry.listed) >= c.ttl
+}
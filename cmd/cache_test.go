@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/resources"
+)
+
+func newCountingListCache(ttl time.Duration, err error) (*ListCache, *int) {
+	count := 0
+	cache := NewListCache(ttl)
+	cache.list = func(item *Item) ([]resources.Resource, error) {
+		count++
+		return nil, err
+	}
+	return cache, &count
+}
+
+func TestListCache(t *testing.T) {
+	item := &Item{Region: &Region{Name: "eu-west-1"}, Type: "TestResource"}
+	other := &Item{Region: &Region{Name: "eu-west-1"}, Type: "OtherResource"}
+
+	cases := []struct {
+		name  string
+		ttl   time.Duration
+		err   error
+		steps func(cache *ListCache)
+		want  int
+	}{
+		{
+			name: "same_pass",
+			ttl:  0,
+			steps: func(cache *ListCache) {
+				cache.StartPass()
+				cache.List(item)
+				cache.List(item)
+				cache.List(other)
+			},
+			want: 2,
+		},
+		{
+			name: "within_ttl",
+			ttl:  time.Hour,
+			steps: func(cache *ListCache) {
+				cache.StartPass()
+				cache.List(item)
+				cache.StartPass()
+				cache.List(item)
+			},
+			want: 1,
+		},
+		{
+			name: "without_ttl",
+			ttl:  0,
+			steps: func(cache *ListCache) {
+				cache.StartPass()
+				cache.List(item)
+				cache.StartPass()
+				cache.List(item)
+			},
+			want: 2,
+		},
+		{
+			name: "invalidated",
+			ttl:  time.Hour,
+			steps: func(cache *ListCache) {
+				cache.StartPass()
+				cache.List(item)
+				cache.Invalidate("eu-west-1", "TestResource")
+				cache.List(item)
+				cache.StartPass()
+				cache.List(item)
+			},
+			want: 2,
+		},
+		{
+			name: "failed",
+			ttl:  time.Hour,
+			err:  fmt.Errorf("boom"),
+			steps: func(cache *ListCache) {
+				cache.StartPass()
+				cache.List(item)
+				cache.StartPass()
+				cache.List(item)
+			},
+			want: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cache, count := newCountingListCache(tc.ttl, tc.err)
+			tc.steps(cache)
+
+			if *count != tc.want {
+				t.Errorf("Wrong number of listings. Want: %d. Have: %d", tc.want, *count)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Confirm asks the operator to confirm the removal of the nukeable resources
+// by typing the account alias, or "yes" if the account has no alias. With
+// --force it waits --force-sleep seconds instead, so there is still a chance
+// to abort. With --no-prompt, or if stdin is no terminal, it continues
+// immediately.
+func (n *Nuke) Confirm(ctx context.Context, nukeable int) error {
+	if n.Parameters.NoPrompt {
+		return nil
+	}
+
+	if !isInteractive() {
+		logrus.Info("Not asking for confirmation, since stdin is no terminal.")
+		return nil
+	}
+
+	if !n.Parameters.Force {
+		expect := n.Account.Alias()
+		if expect == "" {
+			expect = "yes"
+		}
+
+		fmt.Fprintf(n.textOutput(), "Do you really want to remove %d resources of the account with the ID %s and the alias '%s'?\n",
+			nukeable, n.Account.ID(), n.Account.Alias())
+		fmt.Fprintf(n.textOutput(), "Enter '%s' to continue.\n", expect)
+
+		return Prompt(ctx, n.textOutput(), expect)
+	}
+
+	fmt.Fprintf(n.textOutput(), "Removing %d resources of the account with the ID %s and the alias '%s' in %d seconds. Press Ctrl-C to abort.\n",
+		nukeable, n.Account.ID(), n.Account.Alias(), n.Parameters.ForceSleep)
+
+	select {
+	case <-ctx.Done():
+		return ErrInterrupted
+	case <-time.After(time.Duration(n.Parameters.ForceSleep) * time.Second):
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestConfirm(t *testing.T) {
+	defer func(r func() bool) { isInteractive = r }(isInteractive)
+	defer func(r io.Reader) { stdin = r }(stdin)
+
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// blocking never returns any input, like a terminal nobody types into.
+	blocking, _ := io.Pipe()
+
+	cases := []struct {
+		name        string
+		params      NukeParameters
+		interactive bool
+		input       string
+		stdin       io.Reader
+		ctx         context.Context
+		want        error
+		wantErr     bool
+	}{
+		{name: "confirmed", interactive: true, input: "yes\n"},
+		{name: "declined", interactive: true, input: "no\n", wantErr: true},
+		{name: "no-prompt", params: NukeParameters{NoPrompt: true}, interactive: true},
+		{name: "force", params: NukeParameters{Force: true}, interactive: true},
+		{name: "non-interactive", interactive: false},
+		{name: "non-interactive-force", params: NukeParameters{Force: true, ForceSleep: 60}, interactive: false},
+		{
+			name:        "interrupted-prompt",
+			interactive: true,
+			stdin:       blocking,
+			ctx:         cancelled,
+			want:        ErrInterrupted,
+			wantErr:     true,
+		},
+		{
+			name:        "interrupted",
+			params:      NukeParameters{Force: true, ForceSleep: 60},
+			interactive: true,
+			ctx:         cancelled,
+			want:        ErrInterrupted,
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			isInteractive = func() bool { return tc.interactive }
+			stdin = strings.NewReader(tc.input)
+			if tc.stdin != nil {
+				stdin = tc.stdin
+			}
+
+			ctx := tc.ctx
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			n := &Nuke{Parameters: tc.params}
+			err := n.Confirm(ctx, 3)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tc.want != nil && err != tc.want {
+				t.Fatalf("Wrong error. Want: %v. Have: %v", tc.want, err)
+			}
+		})
+	}
+}
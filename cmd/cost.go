@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rebuy-de/aws-nuke/resources"
+)
+
+// EstimateCost annotates the item with the estimated monthly cost of its
+// resource. The cost stays unset, if there is no price for the resource.
+func (i *Item) EstimateCost() {
+	estimator, ok := i.Resource.(resources.CostEstimator)
+	if !ok {
+		return
+	}
+
+	cost, ok := estimator.EstimatedMonthlyCost()
+	if !ok {
+		return
+	}
+
+	i.EstimatedCost = &cost
+}
+
+// EstimatedCost returns the total estimated monthly cost of the items in the
+// given states and the number of those items without a cost estimation.
+func (q Queue) EstimatedCost(states ...ItemState) (float64, int) {
+	var (
+		total   float64
+		unknown int
+	)
+
+	for _, item := range q {
+		if hasState(item, states) {
+			if item.EstimatedCost == nil {
+				unknown++
+				continue
+			}
+			total += *item.EstimatedCost
+		}
+	}
+
+	return total, unknown
+}
+
+func hasState(item *Item, states []ItemState) bool {
+	for _, state := range states {
+		if item.State == state {
+			return true
+		}
+	}
+	return false
+}
+
+func formatCost(cost float64) string {
+	return fmt.Sprintf("~$%.2f/month", cost)
+}
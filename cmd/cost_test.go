@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+)
+
+type testCostResource struct {
+	cost  float64
+	known bool
+}
+
+func (r *testCostResource) Remove() error {
+	return nil
+}
+
+func (r *testCostResource) EstimatedMonthlyCost() (float64, bool) {
+	return r.cost, r.known
+}
+
+func TestQueueEstimatedCost(t *testing.T) {
+	queue := Queue{
+		{State: ItemStateNew, Resource: &testCostResource{cost: 10.5, known: true}},
+		{State: ItemStateNew, Resource: &testCostResource{cost: 2, known: true}},
+		{State: ItemStateNew, Resource: &testCostResource{known: false}},
+		{State: ItemStateNew, Resource: &testLegacyResource{id: "foo"}},
+		{State: ItemStateFiltered, Resource: &testCostResource{cost: 100, known: true}},
+	}
+
+	for _, item := range queue {
+		item.EstimateCost()
+	}
+
+	if queue[2].EstimatedCost != nil {
+		t.Errorf("Expected no cost for resource without price. Have: %f", *queue[2].EstimatedCost)
+	}
+
+	cost, unknown := queue.EstimatedCost(ItemStateNew)
+	if cost != 12.5 {
+		t.Errorf("Wrong cost. Want: 12.5. Have: %f", cost)
+	}
+	if unknown != 2 {
+		t.Errorf("Wrong number of items without cost. Want: 2. Have: %d", unknown)
+	}
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+)
+
+// checkDeadlock counts the consecutive passes over the queue, in which none of
+// the pending, waiting and failed items changed its state, and returns the new
+// count. Once it reaches --deadlock-passes, these items get marked as failed,
+// so the run ends with a clear reason instead of looping until the wait
+// retries are exceeded.
+//
+// Passes, which reused a cached listing of a previous pass, keep the count,
+// since they might not see the resources removed in the meantime.
+func (n *Nuke) checkDeadlock(before []ItemState, stalled int) int {
+	if n.Parameters.DeadlockPasses <= 0 || n.items.Changed(before) || len(n.stuckItems()) == 0 {
+		return 0
+	}
+
+	if n.listCache != nil && n.listCache.Reused() {
+		return stalled
+	}
+
+	stalled = stalled + 1
+	if stalled < n.Parameters.DeadlockPasses {
+		return stalled
+	}
+
+	n.breakDeadlock(stalled)
+	return 0
+}
+
+// stuckItems returns the pending, waiting and failed items, which are still
+// retried.
+func (n *Nuke) stuckItems() Queue {
+	stuck := Queue{}
+	for _, item := range n.items {
+		switch item.State {
+		case ItemStatePending, ItemStateWaiting, ItemStateFailed:
+			if !item.abandoned {
+				stuck = append(stuck, item)
+			}
+		}
+	}
+	return stuck
+}
+
+// breakDeadlock marks all items as failed, which are still waiting or keep
+// failing. They are not retried anymore, because they either block each other
+// or never finish their removal.
+func (n *Nuke) breakDeadlock(passes int) {
+	stuck := n.stuckItems()
+	if len(stuck) == 0 {
+		return
+	}
+
+	logrus.Errorf("No resource changed its state in the last %d passes. "+
+		"Giving up on %d resources, which block each other or never finish their removal:",
+		passes, len(stuck))
+
+	for _, item := range stuck {
+		reason := fmt.Sprintf("deadlock: no progress in %d passes while %s", passes, item.State)
+		if item.Reason != "" {
+			reason = fmt.Sprintf("%s: %s", reason, item.Reason)
+		}
+
+		item.State = ItemStateFailed
+		item.Reason = reason
+		item.abandoned = true
+		n.PrintItem(item)
+	}
+}
@@ -0,0 +1,142 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+	"github.com/rebuy-de/aws-nuke/resources"
+)
+
+func TestNukeCheckDeadlock(t *testing.T) {
+	n := newTestNuke(nil)
+	n.Parameters.DeadlockPasses = 3
+
+	waiting := &Item{Region: &Region{Name: "eu-west-1"}, Type: "TestResource", State: ItemStateWaiting, Resource: &testResource{}}
+	failed := &Item{Region: &Region{Name: "eu-west-1"}, Type: "TestResource", State: ItemStateFailed, Reason: "DependencyViolation", Resource: &testResource{}}
+	finished := &Item{Region: &Region{Name: "eu-west-1"}, Type: "TestResource", State: ItemStateFinished, Resource: &testResource{}}
+	n.items = Queue{waiting, failed, finished}
+
+	stalled := 0
+	for i := 1; i < 3; i++ {
+		stalled = n.checkDeadlock(n.items.States(), stalled)
+		if stalled != i {
+			t.Fatalf("Wrong stalled count. Want: %d. Got: %d", i, stalled)
+		}
+	}
+
+	stalled = n.checkDeadlock(n.items.States(), stalled)
+	if stalled != 0 {
+		t.Errorf("Stalled count was not reset. Got: %d", stalled)
+	}
+
+	for _, item := range []*Item{waiting, failed} {
+		if item.State != ItemStateFailed || !item.abandoned {
+			t.Errorf("Item was not abandoned. State: %v", item.State)
+		}
+		if !strings.HasPrefix(item.Reason, "deadlock: no progress in 3 passes while ") {
+			t.Errorf("Wrong reason: %s", item.Reason)
+		}
+	}
+	if failed.Reason != "deadlock: no progress in 3 passes while failed: DependencyViolation" {
+		t.Errorf("Wrong reason: %s", failed.Reason)
+	}
+	if finished.State != ItemStateFinished {
+		t.Errorf("Finished item changed its state to %v", finished.State)
+	}
+}
+
+func TestNukeCheckDeadlockProgress(t *testing.T) {
+	n := newTestNuke(nil)
+	n.Parameters.DeadlockPasses = 1
+
+	item := &Item{Region: &Region{Name: "eu-west-1"}, Type: "TestResource", State: ItemStatePending, Resource: &testResource{}}
+	n.items = Queue{item}
+
+	before := n.items.States()
+	item.State = ItemStateWaiting
+
+	if stalled := n.checkDeadlock(before, 0); stalled != 0 {
+		t.Errorf("Wrong stalled count. Want: 0. Got: %d", stalled)
+	}
+	if item.State != ItemStateWaiting {
+		t.Errorf("Wrong state. Want: %v. Got: %v", ItemStateWaiting, item.State)
+	}
+}
+
+func TestNukeCheckDeadlockCached(t *testing.T) {
+	n := newTestNuke(nil)
+	n.Parameters.DeadlockPasses = 2
+	n.listCache, _ = newCountingListCache(time.Hour, nil)
+
+	item := &Item{Region: &Region{Name: "eu-west-1"}, Type: "TestResource", State: ItemStateWaiting, Resource: &testResource{}}
+	n.items = Queue{item}
+
+	n.listCache.StartPass()
+	n.listCache.List(item)
+	if stalled := n.checkDeadlock(n.items.States(), 0); stalled != 1 {
+		t.Fatalf("Wrong stalled count. Want: 1. Got: %d", stalled)
+	}
+
+	for i := 0; i < 3; i++ {
+		n.listCache.StartPass()
+		n.listCache.List(item)
+		if stalled := n.checkDeadlock(n.items.States(), 1); stalled != 1 {
+			t.Fatalf("Pass with a cached listing changed the stalled count to %d.", stalled)
+		}
+	}
+
+	if item.abandoned {
+		t.Errorf("Item was abandoned after passes with cached listings.")
+	}
+}
+
+func TestNukeDeadlockWaitingItems(t *testing.T) {
+	n := newTestNuke(nil)
+	n.Parameters.DeadlockPasses = 3
+	n.Parameters.MaxWaitRetries = 10
+	n.Parameters.DeleteConcurrency = 1
+	n.Parameters.Quiet = true
+	n.Parameters.Output = OutputJSON
+
+	// Both resources get listed in every pass, so their removal never
+	// finishes.
+	first := &testResource{props: types.Properties{"Name": "first"}}
+	second := &testResource{props: types.Properties{"Name": "second"}}
+	n.listCache = NewListCache(0)
+	n.listCache.list = func(*Item) ([]resources.Resource, error) {
+		return []resources.Resource{first, second}, nil
+	}
+
+	region := &Region{Name: "eu-west-1"}
+	n.items = Queue{
+		&Item{Region: region, Type: "TestResource", State: ItemStateWaiting, Resource: first},
+		&Item{Region: region, Type: "TestResource", State: ItemStateWaiting, Resource: second},
+	}
+
+	stalled := 0
+	passes := 0
+	for ; passes < n.Parameters.MaxWaitRetries; passes++ {
+		if n.items.Count(ItemStateWaiting, ItemStatePending) == 0 {
+			break
+		}
+
+		before := n.items.States()
+		n.HandleQueue(context.Background())
+		stalled = n.checkDeadlock(before, stalled)
+	}
+
+	if passes >= n.Parameters.MaxWaitRetries {
+		t.Fatalf("The deadlock was not detected within %d passes.", passes)
+	}
+	for _, item := range n.items {
+		if item.State != ItemStateFailed || !item.abandoned {
+			t.Errorf("Item was not abandoned. State: %v", item.State)
+		}
+		if !strings.HasPrefix(item.Reason, "deadlock: no progress in 3 passes while waiting") {
+			t.Errorf("Wrong reason: %s", item.Reason)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"sync"
 
 	"github.com/fatih/color"
 	"github.com/rebuy-de/aws-nuke/resources"
@@ -17,6 +18,35 @@ var (
 	ReasonSuccess         = *color.New(color.FgGreen)
 )
 
+var (
+	ReasonFiltered = *color.New(color.FgHiBlack)
+	ReasonWaiting  = *color.New(color.FgYellow)
+)
+
+// textColors are the colors of the item states for the text output.
+var textColors = map[ItemState]color.Color{
+	ItemStateNew:      ReasonWaitPending,
+	ItemStatePending:  ReasonWaitPending,
+	ItemStateWaiting:  ReasonWaitPending,
+	ItemStateFailed:   ReasonError,
+	ItemStateFiltered: ReasonSkip,
+	ItemStateFinished: ReasonSuccess,
+
+	ItemStateRemovedByDependency: ReasonSkip,
+}
+
+// prettyColors are the colors of the item states for the pretty output.
+var prettyColors = map[ItemState]color.Color{
+	ItemStateNew:      ReasonWaitPending,
+	ItemStatePending:  ReasonRemoveTriggered,
+	ItemStateWaiting:  ReasonWaiting,
+	ItemStateFailed:   ReasonError,
+	ItemStateFiltered: ReasonFiltered,
+	ItemStateFinished: ReasonSuccess,
+
+	ItemStateRemovedByDependency: ReasonFiltered,
+}
+
 var (
 	ColorRegion             = *color.New(color.Bold)
 	ColorResourceType       = *color.New()
@@ -40,7 +70,17 @@ func Sorted(m map[string]string) string {
 	return fmt.Sprintf("[%s]", strings.Join(sorted, ", "))
 }
 
+// logLock prevents lines of concurrently handled items from interleaving.
+var logLock sync.Mutex
+
 func Log(region *Region, resourceType string, r resources.Resource, c color.Color, msg string) {
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	statusLines = 0
+	clearProgress()
+	defer drawProgress()
+
 	ColorRegion.Printf("%s", region.Name)
 	fmt.Printf(" - ")
 	ColorResourceType.Print(resourceType)
@@ -0,0 +1,58 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/notify"
+	log "github.com/sirupsen/logrus"
+)
+
+// Summary summarizes the run on the account for notifications. The error is
+// the result of Run.
+func (n *Nuke) Summary(err error, duration time.Duration) notify.Summary {
+	summary := notify.Summary{
+		AccountID:    n.Account.ID(),
+		AccountAlias: n.Account.Alias(),
+		DryRun:       !n.Parameters.NoDryRun,
+		Success:      err == nil,
+		Duration:     duration,
+		Finished:     n.items.Count(ItemStateFinished),
+		Failed:       n.items.Count(ItemStateFailed),
+		Filtered:     n.items.Count(ItemStateFiltered),
+
+		RemovedByDependency: n.items.Count(ItemStateRemovedByDependency),
+	}
+
+	if err != nil {
+		summary.Error = err.Error()
+	}
+
+	for _, item := range n.items {
+		if item.State != ItemStateFailed {
+			continue
+		}
+
+		record := item.Record()
+		summary.FailedResources = append(summary.FailedResources, notify.FailedResource{
+			Region: record.Region,
+			Type:   record.Type,
+			ID:     record.ID,
+			Reason: record.Reason,
+		})
+	}
+
+	return summary
+}
+
+// sendNotification posts the summary, if a webhook is configured. Errors are
+// only logged, since a broken webhook must not fail the run.
+func sendNotification(notifier *notify.Notifier, summary notify.Summary) {
+	if notifier == nil {
+		return
+	}
+
+	err := notifier.Send(summary)
+	if err != nil {
+		log.Warnf("Failed to notify about account %s: %v", summary.AccountID, err)
+	}
+}
@@ -1,14 +1,20 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/rebuy-de/aws-nuke/pkg/awsutil"
 	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/metrics"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 	"github.com/rebuy-de/aws-nuke/resources"
 	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
 )
 
 type Nuke struct {
@@ -18,7 +24,14 @@ type Nuke struct {
 
 	ResourceTypes types.Collection
 
-	items Queue
+	// Metrics is optional and collects the progress of the run.
+	Metrics *metrics.Metrics
+
+	items     Queue
+	listCache *ListCache
+
+	// resumeState is the loaded state file of an interrupted run.
+	resumeState *State
 }
 
 func NewNuke(params NukeParameters, account awsutil.Account) *Nuke {
@@ -30,55 +43,139 @@ func NewNuke(params NukeParameters, account awsutil.Account) *Nuke {
 	return &n
 }
 
-func (n *Nuke) Run() error {
+// ErrInterrupted is returned, if the run got stopped by a signal.
+var ErrInterrupted = errors.New("interrupted")
+
+// Run scans and nukes the account. Cancelling the context stops issuing new
+// removals, but the current pass over the queue still gets finished, so the
+// summary reflects the actual state of the account.
+func (n *Nuke) Run(ctx context.Context) error {
 	var err error
 
-	fmt.Printf("aws-nuke version %s - %s - %s\n\n", BuildVersion, BuildDate, BuildHash)
+	fmt.Fprintf(n.textOutput(), "aws-nuke version %s - %s - %s\n\n", BuildVersion, BuildDate, BuildHash)
 
 	err = n.Config.ValidateAccount(n.Account.ID(), n.Account.Aliases())
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Nuking the account with the ID %s and the alias '%s'.\n", n.Account.ID(), n.Account.Alias())
+	fmt.Fprintf(n.textOutput(), "Nuking the account with the ID %s and the alias '%s'.\n", n.Account.ID(), n.Account.Alias())
+
+	var previousReport Report
+	if n.Parameters.CompareWith != "" {
+		previousReport, err = ReadReport(n.Parameters.CompareWith)
+		if err != nil {
+			return err
+		}
+		if previousReport.AccountID != n.Account.ID() {
+			logrus.Warnf("The report %s belongs to the account %s and not to %s.",
+				n.Parameters.CompareWith, previousReport.AccountID, n.Account.ID())
+		}
+	}
+
+	if n.Parameters.NoDryRun {
+		err = n.LoadResumeState()
+		if err != nil {
+			return err
+		}
+	}
 
 	err = n.Scan()
 	if err != nil {
 		return err
 	}
 
+	if n.Parameters.CompareWith != "" {
+		n.PrintReportDiff(CompareReports(previousReport, n.Report(n.items)))
+	}
+
+	if ctx.Err() != nil {
+		return ErrInterrupted
+	}
+
+	if !n.Parameters.NoDryRun && n.Parameters.DryRunReport != "" {
+		err = WriteReport(n.Parameters.DryRunReport, n.Report(n.items))
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(n.textOutput(), "Wrote dry run report to %s.\n", n.Parameters.DryRunReport)
+	}
+
 	if n.items.Count(ItemStateNew) == 0 {
-		fmt.Println("No resource to delete.")
+		fmt.Fprintln(n.textOutput(), "No resource to delete.")
+		if n.Parameters.NoDryRun {
+			return n.RemoveState()
+		}
 		return nil
 	}
 
 	if !n.Parameters.NoDryRun {
-		fmt.Println("The above resources would be deleted with the supplied configuration. Provide --no-dry-run to actually destroy resources.")
+		fmt.Fprintln(n.textOutput(), "The above resources would be deleted with the supplied configuration. Provide --no-dry-run to actually destroy resources.")
 		return nil
 	}
 
-	fmt.Printf("Nuking the resources on the account with the ID %s and the alias '%s'?\n", n.Account.ID(), n.Account.Alias())
+	err = n.ResumeState()
+	if err != nil {
+		return err
+	}
+
+	nukeable := n.items.Count(ItemStateNew)
+	if n.Parameters.MaxDeletions > 0 && nukeable > n.Parameters.MaxDeletions {
+		return fmt.Errorf("Found %d nukeable resources, which exceeds the limit of %d. "+
+			"Raise --max-deletions, if this is intended.", nukeable, n.Parameters.MaxDeletions)
+	}
+
+	err = n.Confirm(ctx, nukeable)
+	if err != nil {
+		return err
+	}
+
+	if n.Parameters.FailureReport != "" {
+		defer n.WriteFailureReport()
+	}
+
+	n.items.SortByPriority(n.Config.DeletionPriorities)
 
 	failCount := 0
 	waitingCount := 0
+	stalledCount := 0
 
 	for {
-		n.HandleQueue()
+		before := n.items.States()
+		n.HandleQueue(ctx)
+
+		err = n.SaveState()
+		if err != nil {
+			logrus.Warn(err)
+		}
+
+		if ctx.Err() != nil {
+			logrus.Warn("Interrupted. No further resources get removed.")
+			fmt.Fprintf(n.textOutput(), "Nuke interrupted: %d waiting, %d failed, %d filtered, %d removed by dependencies, %d finished, %d not attempted.\n\n",
+				n.items.Count(ItemStateWaiting, ItemStatePending), n.items.Count(ItemStateFailed),
+				n.items.Count(ItemStateFiltered), n.items.Count(ItemStateRemovedByDependency),
+				n.items.Count(ItemStateFinished), n.items.Count(ItemStateNew))
+			n.PrintRecords(PhaseResult)
+			return ErrInterrupted
+		}
+
+		stalledCount = n.checkDeadlock(before, stalledCount)
 
 		if n.items.Count(ItemStatePending, ItemStateWaiting, ItemStateNew) == 0 && n.items.Count(ItemStateFailed) > 0 {
-			if failCount >= 2 {
+			if failCount >= n.Parameters.MaxFailRetries {
 				logrus.Errorf("There are resources in failed state, but none are ready for deletion, anymore.")
-				fmt.Println()
+				fmt.Fprintln(n.textOutput())
 
 				for _, item := range n.items {
 					if item.State != ItemStateFailed {
 						continue
 					}
 
-					item.Print()
+					n.PrintItem(item)
 					logrus.Error(item.Reason)
 				}
 
+				n.PrintRecords(PhaseResult)
 				return fmt.Errorf("failed")
 			}
 
@@ -88,6 +185,7 @@ func (n *Nuke) Run() error {
 		}
 		if n.Parameters.MaxWaitRetries != 0 && n.items.Count(ItemStateWaiting, ItemStatePending) > 0 && n.items.Count(ItemStateNew) == 0 {
 			if waitingCount >= n.Parameters.MaxWaitRetries {
+				n.PrintRecords(PhaseResult)
 				return fmt.Errorf("Max wait retries of %d exceeded.\n\n", n.Parameters.MaxWaitRetries)
 			}
 			waitingCount = waitingCount + 1
@@ -98,13 +196,19 @@ func (n *Nuke) Run() error {
 			break
 		}
 
-		time.Sleep(5 * time.Second)
+		select {
+		case <-ctx.Done():
+		case <-time.After(n.Parameters.PollInterval):
+		}
 	}
 
-	fmt.Printf("Nuke complete: %d failed, %d skipped, %d finished.\n\n",
-		n.items.Count(ItemStateFailed), n.items.Count(ItemStateFiltered), n.items.Count(ItemStateFinished))
+	fmt.Fprintf(n.textOutput(), "Nuke complete: %d failed, %d filtered, %d removed by dependencies, %d finished.\n\n",
+		n.items.Count(ItemStateFailed), n.items.Count(ItemStateFiltered),
+		n.items.Count(ItemStateRemovedByDependency), n.items.Count(ItemStateFinished))
 
-	return nil
+	n.PrintRecords(PhaseResult)
+
+	return n.RemoveState()
 }
 
 func (n *Nuke) Scan() error {
@@ -119,30 +223,62 @@ func (n *Nuke) Scan() error {
 		excludes = defaultCfg.ResourceTypes.Excludes
 	}
 
-	resourceTypes := ResolveResourceTypes(
-		resources.GetListerNames(),
-		[]types.Collection{
-			n.Parameters.Targets,
-			n.Config.ResourceTypes.Targets,
-			targets,
-		},
-		[]types.Collection{
-			n.Parameters.Excludes,
-			n.Config.ResourceTypes.Excludes,
-			excludes,
-		},
-	)
+	includes := []types.Collection{
+		n.Parameters.Targets,
+		n.Config.ResourceTypes.Targets,
+		targets,
+	}
+	exclusions := []types.Collection{
+		n.Parameters.Excludes,
+		n.Config.ResourceTypes.Excludes,
+		excludes,
+	}
+
+	groups := n.Config.ResourceTypeGroups
+	unknown := UnknownResourceTypes(resources.GetListerNames(),
+		ExpandResourceTypes(resources.GetListerNames(), groups, append(includes, exclusions...))...)
+	if len(unknown) > 0 {
+		msg := fmt.Sprintf("Unknown resource types in targets or excludes: %s. "+
+			"See 'aws-nuke resource-types' for all supported types.", strings.Join(unknown, ", "))
+		if n.Parameters.Strict {
+			return fmt.Errorf("%s", msg)
+		}
+		logrus.Warn(msg)
+	}
+
+	resourceTypes := ResolveResourceTypes(resources.GetListerNames(), includes, exclusions, groups)
 
 	queue := make(Queue, 0)
 
-	for _, regionName := range n.Config.Regions {
-		region := NewRegion(regionName, n.Account.ResourceTypeToServiceType, n.Account.NewSession)
+	featureFlags, err := n.Config.AccountFeatureFlags(n.Account.ID())
+	if err != nil {
+		return err
+	}
 
-		items := Scan(region, resourceTypes)
-		for item := range items {
+	regions := n.Config.Regions
+	if NeedsEnabledRegions(regions) {
+		enabled, err := n.Account.EnabledRegions()
+		if err != nil {
+			return err
+		}
+
+		regions, err = ResolveRegions(regions, enabled)
+		if err != nil {
+			return err
+		}
+		logrus.Debugf("resolved regions: %s", strings.Join(regions, ", "))
+	}
+
+	// Cancelling stops the scanners of the remaining regions, if the scan
+	// gets aborted by an error.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	for _, items := range n.scanRegions(ctx, regions, resourceTypes) {
+		for _, item := range <-items {
 			ffGetter, ok := item.Resource.(resources.FeatureFlagGetter)
 			if ok {
-				ffGetter.FeatureFlags(n.Config.FeatureFlags)
+				ffGetter.FeatureFlags(featureFlags)
 			}
 
 			queue = append(queue, item)
@@ -151,20 +287,79 @@ func (n *Nuke) Scan() error {
 				return err
 			}
 
+			if n.Parameters.EstimateCost {
+				item.EstimateCost()
+			}
+
 			if item.State != ItemStateFiltered || !n.Parameters.Quiet {
-				item.Print()
+				n.PrintItem(item)
 			}
 		}
 	}
 
-	fmt.Printf("Scan complete: %d total, %d nukeable, %d filtered.\n\n",
+	accountFilters, err := n.Config.Filters(n.Account.ID())
+	if err != nil {
+		return err
+	}
+	unreported := UnreportedFilterProperties(accountFilters, queue)
+	if len(unreported) > 0 {
+		msg := fmt.Sprintf("Filters reference properties, which no scanned resource reports: %s. "+
+			"These filters never match, so check the property names.", strings.Join(unreported, ", "))
+		if n.Parameters.Strict {
+			return fmt.Errorf("%s", msg)
+		}
+		logrus.Warn(msg)
+	}
+
+	fmt.Fprintf(n.textOutput(), "Scan complete: %d total, %d nukeable, %d filtered.\n\n",
 		queue.CountTotal(), queue.Count(ItemStateNew), queue.Count(ItemStateFiltered))
 
+	if n.Parameters.EstimateCost {
+		cost, unknown := queue.EstimatedCost(ItemStateNew)
+		fmt.Fprintf(n.textOutput(), "Estimated cost of nukeable resources: %s (%d without pricing data).\n\n",
+			formatCost(cost), unknown)
+	}
+
 	n.items = queue
+	n.updateMetrics()
+	n.PrintRecords(PhaseScan)
 
 	return nil
 }
 
+// scanRegions lists the resources of the given regions concurrently, with at
+// most Parameters.ScanConcurrency regions at the same time. The returned
+// channels are in the same order as the regions, so the caller can process
+// the results deterministically while later regions are still being scanned.
+// After the context got cancelled, no further resource types get listed and
+// the channels of the regions, which were not scanned yet, get closed.
+func (n *Nuke) scanRegions(ctx context.Context, regions, resourceTypes []string) []<-chan []*Item {
+	sem := semaphore.NewWeighted(int64(n.Parameters.ScanConcurrency))
+
+	results := make([]<-chan []*Item, len(regions))
+	for i, regionName := range regions {
+		region := NewRegion(regionName, n.Account.ResourceTypeToServiceType, n.Account.NewSession)
+		result := make(chan []*Item, 1)
+		results[i] = result
+
+		go func() {
+			if ctx.Err() != nil || sem.Acquire(ctx, 1) != nil {
+				close(result)
+				return
+			}
+			defer sem.Release(1)
+
+			items := []*Item{}
+			for item := range Scan(ctx, region, n.resumeTypes(region.Name, resourceTypes)) {
+				items = append(items, item)
+			}
+			result <- items
+		}()
+	}
+
+	return results
+}
+
 func (n *Nuke) Filter(item *Item) error {
 
 	checker, ok := item.Resource.(resources.Filter)
@@ -181,6 +376,29 @@ func (n *Nuke) Filter(item *Item) error {
 		}
 	}
 
+	getter, ok := item.Resource.(resources.ResourcePropertyGetter)
+	if ok && len(n.Config.GlobalTagFilters) > 0 {
+		properties := getter.Properties()
+		for _, tagFilter := range n.Config.GlobalTagFilters {
+			filter := tagFilter.Filter()
+			value, ok := properties[filter.Property]
+			if !ok {
+				continue
+			}
+
+			match, err := filter.Match(value)
+			if err != nil {
+				return err
+			}
+
+			if match {
+				item.State = ItemStateFiltered
+				item.Reason = "filtered by global tag filter"
+				return nil
+			}
+		}
+	}
+
 	accountFilters, err := n.Config.Filters(n.Account.ID())
 	if err != nil {
 		return err
@@ -192,17 +410,11 @@ func (n *Nuke) Filter(item *Item) error {
 	}
 
 	for _, filter := range itemFilters {
-		prop, err := item.GetProperty(filter.Property)
-
-		match, err := filter.Match(prop)
+		match, err := matchFilter(item, filter)
 		if err != nil {
 			return err
 		}
 
-		if IsTrue(filter.Invert) {
-			match = !match
-		}
-
 		if match {
 			item.State = ItemStateFiltered
 			item.Reason = "filtered by config"
@@ -213,33 +425,179 @@ func (n *Nuke) Filter(item *Item) error {
 	return nil
 }
 
-func (n *Nuke) HandleQueue() {
-	listCache := make(map[string]map[string][]resources.Resource)
+// UnreportedFilterProperties returns the properties referenced by the filters,
+// which no scanned resource of the type reported. Such filters never match,
+// which usually means that the property name has a typo. Tags are not
+// checked, since resources only report the tags they actually have.
+func UnreportedFilterProperties(filters config.Filters, items Queue) []string {
+	reported := map[string]map[string]bool{}
+	for _, item := range items {
+		getter, ok := item.Resource.(resources.ResourcePropertyGetter)
+		if !ok {
+			continue
+		}
+
+		if reported[item.Type] == nil {
+			reported[item.Type] = map[string]bool{}
+		}
+		for key := range getter.Properties() {
+			reported[item.Type][key] = true
+		}
+	}
+
+	unreported := []string{}
+	seen := map[string]bool{}
+
+	var check func(resourceType string, filter config.Filter)
+	check = func(resourceType string, filter config.Filter) {
+		for _, condition := range filter.Conditions {
+			check(resourceType, condition)
+		}
+
+		property := filter.Property
+		if len(filter.Conditions) > 0 || property == "" || strings.HasPrefix(property, "tag:") {
+			return
+		}
+
+		name := fmt.Sprintf("%s.%s", resourceType, property)
+		if !reported[resourceType][property] && !seen[name] {
+			unreported = append(unreported, name)
+			seen[name] = true
+		}
+	}
+
+	for resourceType, typeFilters := range filters {
+		if reported[resourceType] == nil {
+			continue
+		}
+		for _, filter := range typeFilters {
+			check(resourceType, filter)
+		}
+	}
+
+	sort.Strings(unreported)
+	return unreported
+}
+
+// matchFilter checks whether the filter matches the item. Filters with
+// conditions match depending on their operator, if all or any of the
+// conditions match.
+func matchFilter(item *Item, filter config.Filter) (bool, error) {
+	var match bool
+
+	if len(filter.Conditions) > 0 {
+		match = filter.Operator == config.FilterOperatorAnd
+		for _, condition := range filter.Conditions {
+			conditionMatch, err := matchFilter(item, condition)
+			if err != nil {
+				return false, err
+			}
+
+			if conditionMatch != match {
+				match = conditionMatch
+				break
+			}
+		}
+	} else {
+		// A filter that references a property, which the resource does not
+		// support, is a configuration error. Treating it as a mismatch would
+		// silently delete resources that were meant to be protected.
+		prop, err := item.GetProperty(filter.Property)
+		if err != nil {
+			return false, fmt.Errorf("failed to apply filter on %s: %v", item.Type, err)
+		}
+
+		match, err = filter.Match(prop)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if IsTrue(filter.Invert) {
+		match = !match
+	}
+
+	return match, nil
+}
+
+func (n *Nuke) HandleQueue(ctx context.Context) {
+	if n.listCache == nil {
+		n.listCache = NewListCache(n.Parameters.ListCacheTTL)
+	}
+	listCache := n.listCache
+	listCache.StartPass()
+
+	var progress *Progress
+	if n.showProgress() {
+		progress = NewProgress(n.items)
+		setProgress(progress.String())
+	}
+
+	concurrency := int64(n.Parameters.DeleteConcurrency)
+	sem := semaphore.NewWeighted(concurrency)
 
 	for _, item := range n.items {
-		switch item.State {
-		case ItemStateNew:
-			n.HandleRemove(item)
-			item.Print()
-		case ItemStateFailed:
-			n.HandleRemove(item)
-			n.HandleWait(item, listCache)
-			item.Print()
-		case ItemStatePending:
-			n.HandleWait(item, listCache)
-			item.State = ItemStateWaiting
-			item.Print()
-		case ItemStateWaiting:
-			n.HandleWait(item, listCache)
-			item.Print()
-		}
-
-	}
-
-	fmt.Println()
-	fmt.Printf("Removal requested: %d waiting, %d failed, %d skipped, %d finished\n\n",
-		n.items.Count(ItemStateWaiting, ItemStatePending), n.items.Count(ItemStateFailed),
-		n.items.Count(ItemStateFiltered), n.items.Count(ItemStateFinished))
+		sem.Acquire(context.Background(), 1)
+		go func(item *Item) {
+			defer sem.Release(1)
+			from := item.State
+			n.HandleItem(ctx, item, listCache)
+			if progress != nil {
+				setProgress(progress.Update(from, item.State))
+			}
+		}(item)
+	}
+
+	// Wait for all routines to finish.
+	sem.Acquire(context.Background(), concurrency)
+
+	if progress != nil {
+		setProgress("")
+	}
+
+	n.updateMetrics()
+
+	n.PrintStatus()
+}
+
+// HandleItem advances a single item by one step. Every item is only handled
+// by a single routine at a time, but different items might be handled
+// concurrently. After the context got cancelled, only the removal of already
+// triggered items gets checked.
+func (n *Nuke) HandleItem(ctx context.Context, item *Item, listCache *ListCache) {
+	if ctx.Err() != nil && (item.State == ItemStateNew || item.State == ItemStateFailed) {
+		return
+	}
+
+	switch item.State {
+	case ItemStateNew:
+		n.HandleRemove(item)
+		n.invalidateListCache(item, listCache)
+		n.PrintItem(item)
+	case ItemStateFailed:
+		if item.abandoned {
+			return
+		}
+		n.HandleRemove(item)
+		n.invalidateListCache(item, listCache)
+		n.HandleWait(item, listCache)
+		n.PrintItem(item)
+	case ItemStatePending:
+		n.HandleWait(item, listCache)
+		item.State = ItemStateWaiting
+		n.PrintItem(item)
+	case ItemStateWaiting:
+		n.HandleWait(item, listCache)
+		n.PrintItem(item)
+	}
+}
+
+// invalidateListCache drops the cached listing of the item type, if its
+// removal got triggered.
+func (n *Nuke) invalidateListCache(item *Item, listCache *ListCache) {
+	if item.State == ItemStatePending {
+		listCache.Invalidate(item.Region.Name, item.Type)
+	}
 }
 
 func (n *Nuke) HandleRemove(item *Item) {
@@ -247,29 +605,24 @@ func (n *Nuke) HandleRemove(item *Item) {
 	if err != nil {
 		item.State = ItemStateFailed
 		item.Reason = err.Error()
+		item.PendingSince = time.Time{}
 		return
 	}
 
+	if item.PendingSince.IsZero() {
+		item.PendingSince = time.Now()
+	}
+	item.triggered = true
 	item.State = ItemStatePending
 	item.Reason = ""
 }
 
-func (n *Nuke) HandleWait(item *Item, cache map[string]map[string][]resources.Resource) {
-	var err error
-	region := item.Region.Name
-	_, ok := cache[region]
-	if !ok {
-		cache[region] = map[string][]resources.Resource{}
-	}
-	left, ok := cache[region][item.Type]
-	if !ok {
-		left, err = item.List()
-		if err != nil {
-			item.State = ItemStateFailed
-			item.Reason = err.Error()
-			return
-		}
-		cache[region][item.Type] = left
+func (n *Nuke) HandleWait(item *Item, cache *ListCache) {
+	left, err := cache.List(item)
+	if err != nil {
+		item.State = ItemStateFailed
+		item.Reason = err.Error()
+		return
 	}
 
 	for _, r := range left {
@@ -282,10 +635,70 @@ func (n *Nuke) HandleWait(item *Item, cache map[string]map[string][]resources.Re
 				}
 			}
 
+			if removal, ok := r.(resources.RemovalChecker); ok {
+				if err := removal.RemovalError(); err != nil {
+					item.State = ItemStateFailed
+					item.Reason = err.Error()
+					return
+				}
+			}
+
+			n.checkDeleteTimeout(item)
 			return
 		}
 	}
 
+	if item.State == ItemStateFailed && !item.triggered {
+		item.State = ItemStateRemovedByDependency
+		item.Reason = "removed together with a dependency"
+		return
+	}
+
 	item.State = ItemStateFinished
 	item.Reason = ""
+	if scheduler, ok := item.Resource.(resources.DeletionScheduler); ok {
+		item.Reason = scheduler.DeletionSchedule()
+	}
+	n.Metrics.IncRemoved(item.Type)
+}
+
+// checkDeleteTimeout marks the item as failed, if its removal takes longer
+// than the configured timeout. Timed out items are not retried.
+func (n *Nuke) checkDeleteTimeout(item *Item) {
+	timeout := n.deleteTimeout(item.Type)
+	if timeout <= 0 || item.PendingSince.IsZero() {
+		return
+	}
+
+	if time.Since(item.PendingSince) <= timeout {
+		return
+	}
+
+	item.State = ItemStateFailed
+	item.Reason = fmt.Sprintf("removal timed out after %v", timeout)
+	item.abandoned = true
+}
+
+// deleteTimeout returns the timeout of the resource type from the config or
+// the global timeout from the parameters otherwise.
+func (n *Nuke) deleteTimeout(resourceType string) time.Duration {
+	if n.Config != nil {
+		timeout, ok := n.Config.DeleteTimeouts[resourceType]
+		if ok {
+			return timeout
+		}
+	}
+	return n.Parameters.DeleteTimeout
+}
+
+func (n *Nuke) updateMetrics() {
+	if n.Metrics == nil {
+		return
+	}
+
+	states := map[string]int{}
+	for _, item := range n.items {
+		states[item.State.String()]++
+	}
+	n.Metrics.SetStates(states)
 }
@@ -0,0 +1,435 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+	"github.com/rebuy-de/aws-nuke/resources"
+)
+
+type testResource struct {
+	props types.Properties
+}
+
+func (r *testResource) Remove() error {
+	return nil
+}
+
+func (r *testResource) Properties() types.Properties {
+	return r.props
+}
+
+type testLegacyResource struct {
+	id string
+}
+
+func (r *testLegacyResource) Remove() error {
+	return nil
+}
+
+func (r *testLegacyResource) String() string {
+	return r.id
+}
+
+func newTestNuke(filters config.Filters) *Nuke {
+	return &Nuke{
+		Config: &config.Nuke{
+			Accounts: map[string]config.Account{
+				"__default__": {Filters: filters},
+			},
+		},
+	}
+}
+
+func TestNukeFilter(t *testing.T) {
+	n := newTestNuke(config.Filters{
+		"TestResource": {
+			{Property: "Name", Type: config.FilterTypeExact, Value: "protected"},
+		},
+	})
+
+	cases := []struct {
+		name  string
+		props types.Properties
+		state ItemState
+	}{
+		{name: "match", props: types.Properties{"Name": "protected"}, state: ItemStateFiltered},
+		{name: "mismatch", props: types.Properties{"Name": "other"}, state: ItemStateNew},
+		// Missing properties do not match. Filters on properties, which no
+		// resource reports, are warned about by UnreportedFilterProperties.
+		{name: "missing", props: types.Properties{}, state: ItemStateNew},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := &Item{
+				Type:     "TestResource",
+				State:    ItemStateNew,
+				Resource: &testResource{props: tc.props},
+			}
+
+			err := n.Filter(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if item.State != tc.state {
+				t.Errorf("Wrong state. Want: %v. Got: %v", tc.state, item.State)
+			}
+		})
+	}
+}
+
+func TestUnreportedFilterProperties(t *testing.T) {
+	filters := config.Filters{
+		"TestResource": {
+			{Property: "Name", Type: config.FilterTypeExact, Value: "protected"},
+			{Property: "Nmae", Type: config.FilterTypeExact, Value: "protected"},
+			{Property: "tag:Env", Type: config.FilterTypeExact, Value: "prod"},
+			{Operator: config.FilterOperatorOr, Conditions: []config.Filter{
+				{Property: "Owner", Type: config.FilterTypeExact, Value: "admin"},
+				{Property: "Name", Type: config.FilterTypeExact, Value: "admin"},
+			}},
+		},
+		"OtherResource": {
+			{Property: "Typo", Type: config.FilterTypeExact, Value: "foo"},
+		},
+	}
+
+	items := Queue{
+		{Type: "TestResource", Resource: &testResource{props: types.Properties{"Name": "foo"}}},
+		{Type: "TestResource", Resource: &testResource{props: types.Properties{"Name": "bar", "tag:Team": "a"}}},
+	}
+
+	want := "[TestResource.Nmae TestResource.Owner]"
+	have := fmt.Sprint(UnreportedFilterProperties(filters, items))
+	if want != have {
+		t.Errorf("Wrong result. Want: %s. Have: %s", want, have)
+	}
+}
+
+func TestNukeFilterContainsInverted(t *testing.T) {
+	n := newTestNuke(config.Filters{
+		"TestResource": {
+			{Property: "ARN", Type: config.FilterTypeContains, Value: "prod", Invert: "true"},
+		},
+	})
+
+	cases := []struct {
+		name  string
+		props types.Properties
+		state ItemState
+	}{
+		{name: "contains", props: types.Properties{"ARN": "arn:aws:s3:::my-prod-bucket"}, state: ItemStateNew},
+		{name: "not_contains", props: types.Properties{"ARN": "arn:aws:s3:::my-dev-bucket"}, state: ItemStateFiltered},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := &Item{
+				Type:     "TestResource",
+				State:    ItemStateNew,
+				Resource: &testResource{props: tc.props},
+			}
+
+			err := n.Filter(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if item.State != tc.state {
+				t.Errorf("Wrong state. Want: %v. Got: %v", tc.state, item.State)
+			}
+		})
+	}
+}
+
+func TestNukeFilterConditions(t *testing.T) {
+	conditions := []config.Filter{
+		{Property: "Name", Type: config.FilterTypePrefix, Value: "ci-"},
+		{Property: "Env", Type: config.FilterTypeExact, Value: "test"},
+	}
+
+	cases := []struct {
+		name     string
+		operator string
+		props    types.Properties
+		state    ItemState
+	}{
+		{name: "and_both", operator: config.FilterOperatorAnd, props: types.Properties{"Name": "ci-stack", "Env": "test"}, state: ItemStateFiltered},
+		{name: "and_one", operator: config.FilterOperatorAnd, props: types.Properties{"Name": "ci-stack", "Env": "prod"}, state: ItemStateNew},
+		{name: "or_one", operator: config.FilterOperatorOr, props: types.Properties{"Name": "ci-stack", "Env": "prod"}, state: ItemStateFiltered},
+		{name: "or_none", operator: config.FilterOperatorOr, props: types.Properties{"Name": "stack", "Env": "prod"}, state: ItemStateNew},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := newTestNuke(config.Filters{
+				"TestResource": {
+					{Operator: tc.operator, Conditions: conditions},
+				},
+			})
+
+			item := &Item{
+				Type:     "TestResource",
+				State:    ItemStateNew,
+				Resource: &testResource{props: tc.props},
+			}
+
+			err := n.Filter(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if item.State != tc.state {
+				t.Errorf("Wrong state. Want: %v. Got: %v", tc.state, item.State)
+			}
+		})
+	}
+}
+
+func TestNukeFilterUnsupportedProperty(t *testing.T) {
+	n := newTestNuke(config.Filters{
+		"TestResource": {
+			{Property: "Name", Type: config.FilterTypeExact, Value: "protected"},
+		},
+	})
+
+	item := &Item{
+		Type:     "TestResource",
+		State:    ItemStateNew,
+		Resource: &testLegacyResource{id: "protected"},
+	}
+
+	err := n.Filter(item)
+	if err == nil {
+		t.Fatal("Expected an error but didn't get one.")
+	}
+}
+
+func TestNukeFilterGlobalTags(t *testing.T) {
+	n := newTestNuke(nil)
+	n.Config.GlobalTagFilters = []config.TagFilter{
+		{Key: "aws-nuke", Value: "protected"},
+	}
+
+	cases := []struct {
+		name     string
+		resource resources.Resource
+		state    ItemState
+	}{
+		{
+			name:     "tagged",
+			resource: &testResource{props: types.Properties{"tag:aws-nuke": "protected"}},
+			state:    ItemStateFiltered,
+		},
+		{
+			name:     "other_value",
+			resource: &testResource{props: types.Properties{"tag:aws-nuke": "no"}},
+			state:    ItemStateNew,
+		},
+		{
+			name:     "related_resource_tag",
+			resource: &testResource{props: types.Properties{"tag:vpc:aws-nuke": "protected"}},
+			state:    ItemStateNew,
+		},
+		{
+			name:     "untagged",
+			resource: &testResource{props: types.Properties{"Name": "protected"}},
+			state:    ItemStateNew,
+		},
+		{
+			name:     "no_properties",
+			resource: &testLegacyResource{id: "protected"},
+			state:    ItemStateNew,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := &Item{
+				Type:     "TestResource",
+				State:    ItemStateNew,
+				Resource: tc.resource,
+			}
+
+			err := n.Filter(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if item.State != tc.state {
+				t.Errorf("Wrong state. Want: %v. Got: %v", tc.state, item.State)
+			}
+		})
+	}
+}
+
+func TestNukeCheckDeleteTimeout(t *testing.T) {
+	n := newTestNuke(nil)
+	n.Parameters.DeleteTimeout = time.Hour
+	n.Config.DeleteTimeouts = map[string]time.Duration{
+		"SlowResource":      3 * time.Hour,
+		"UnlimitedResource": 0,
+	}
+
+	cases := []struct {
+		name         string
+		resourceType string
+		pendingSince time.Duration
+		state        ItemState
+	}{
+		{name: "within_global", resourceType: "TestResource", pendingSince: 30 * time.Minute, state: ItemStateWaiting},
+		{name: "exceeds_global", resourceType: "TestResource", pendingSince: 2 * time.Hour, state: ItemStateFailed},
+		{name: "within_override", resourceType: "SlowResource", pendingSince: 2 * time.Hour, state: ItemStateWaiting},
+		{name: "exceeds_override", resourceType: "SlowResource", pendingSince: 4 * time.Hour, state: ItemStateFailed},
+		{name: "disabled", resourceType: "UnlimitedResource", pendingSince: 100 * time.Hour, state: ItemStateWaiting},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := &Item{
+				Type:         tc.resourceType,
+				State:        ItemStateWaiting,
+				Resource:     &testResource{},
+				PendingSince: time.Now().Add(-tc.pendingSince),
+			}
+
+			n.checkDeleteTimeout(item)
+
+			if item.State != tc.state {
+				t.Errorf("Wrong state. Want: %v. Got: %v", tc.state, item.State)
+			}
+		})
+	}
+}
+
+func TestNukeHandleItemSkipsTimedOut(t *testing.T) {
+	n := newTestNuke(nil)
+	item := &Item{
+		Type:      "TestResource",
+		State:     ItemStateFailed,
+		Resource:  &testResource{},
+		abandoned: true,
+	}
+
+	n.HandleItem(context.Background(), item, NewListCache(0))
+
+	if item.State != ItemStateFailed {
+		t.Errorf("Wrong state. Want: %v. Got: %v", ItemStateFailed, item.State)
+	}
+}
+
+type testFailingResource struct {
+	testResource
+}
+
+func (r *testFailingResource) Remove() error {
+	return errors.New("DependencyViolation")
+}
+
+func TestNukeHandleItemRemovedByDependency(t *testing.T) {
+	cases := []struct {
+		name      string
+		resource  resources.Resource
+		triggered bool
+		want      ItemState
+	}{
+		{name: "never-removed", resource: &testFailingResource{}, want: ItemStateRemovedByDependency},
+		{name: "triggered-before", resource: &testFailingResource{}, triggered: true, want: ItemStateFinished},
+		{name: "removed", resource: &testResource{}, want: ItemStateFinished},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			n := newTestNuke(nil)
+			item := &Item{
+				Region:    &Region{Name: "eu-west-1"},
+				Type:      "TestResource",
+				State:     ItemStateFailed,
+				Resource:  tc.resource,
+				triggered: tc.triggered,
+			}
+
+			cache := NewListCache(0)
+			cache.list = func(*Item) ([]resources.Resource, error) {
+				return nil, nil
+			}
+
+			n.HandleItem(context.Background(), item, cache)
+
+			if item.State != tc.want {
+				t.Errorf("Wrong state. Want: %v. Got: %v", tc.want, item.State)
+			}
+		})
+	}
+}
+
+type testScheduledResource struct {
+	testResource
+}
+
+func (r *testScheduledResource) DeletionSchedule() string {
+	return "scheduled for deletion in 7 days"
+}
+
+func TestNukeHandleWaitScheduled(t *testing.T) {
+	n := newTestNuke(nil)
+	item := &Item{
+		Region:   &Region{Name: "eu-west-1"},
+		Type:     "TestResource",
+		State:    ItemStateWaiting,
+		Resource: &testScheduledResource{},
+	}
+
+	cache := NewListCache(0)
+	cache.list = func(*Item) ([]resources.Resource, error) {
+		return nil, nil
+	}
+
+	n.HandleWait(item, cache)
+
+	if item.State != ItemStateFinished {
+		t.Errorf("Wrong state. Want: %v. Got: %v", ItemStateFinished, item.State)
+	}
+	if item.Reason != "scheduled for deletion in 7 days" {
+		t.Errorf("Wrong reason: %s", item.Reason)
+	}
+}
+
+type testFailedResource struct {
+	testResource
+}
+
+func (r *testFailedResource) RemovalError() error {
+	return errors.New("stack is in DELETE_FAILED")
+}
+
+func TestNukeHandleWaitRemovalFailed(t *testing.T) {
+	n := newTestNuke(nil)
+	item := &Item{
+		Region:   &Region{Name: "eu-west-1"},
+		Type:     "TestResource",
+		State:    ItemStateWaiting,
+		Resource: &testFailedResource{},
+	}
+
+	cache := NewListCache(0)
+	cache.list = func(*Item) ([]resources.Resource, error) {
+		return []resources.Resource{&testFailedResource{}}, nil
+	}
+
+	n.HandleWait(item, cache)
+
+	if item.State != ItemStateFailed {
+		t.Errorf("Wrong state. Want: %v. Got: %v", ItemStateFailed, item.State)
+	}
+	if item.Reason != "stack is in DELETE_FAILED" {
+		t.Errorf("Wrong reason: %s", item.Reason)
+	}
+}
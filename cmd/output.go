@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/rebuy-de/aws-nuke/resources"
+	log "github.com/sirupsen/logrus"
+)
+
+// Output formats for the results of the scan and the removal.
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+)
+
+// ItemRecord is the machine readable representation of an Item.
+type ItemRecord struct {
+	Region     string            `json:"region" yaml:"region"`
+	Type       string            `json:"type" yaml:"type"`
+	ID         string            `json:"id,omitempty" yaml:"id,omitempty"`
+	Properties map[string]string `json:"properties,omitempty" yaml:"properties,omitempty"`
+	State      string            `json:"state" yaml:"state"`
+	Reason     string            `json:"reason,omitempty" yaml:"reason,omitempty"`
+
+	EstimatedMonthlyCost *float64 `json:"estimated-monthly-cost,omitempty" yaml:"estimated-monthly-cost,omitempty"`
+}
+
+func (i *Item) Record() ItemRecord {
+	record := ItemRecord{
+		Region: i.Region.Name,
+		Type:   i.Type,
+		State:  i.State.String(),
+		Reason: i.Reason,
+
+		EstimatedMonthlyCost: i.EstimatedCost,
+	}
+
+	rString, ok := i.Resource.(resources.LegacyStringer)
+	if ok {
+		record.ID = rString.String()
+	}
+
+	rProp, ok := i.Resource.(resources.ResourcePropertyGetter)
+	if ok {
+		record.Properties = rProp.Properties()
+	}
+
+	return record
+}
+
+func (q Queue) Records() []ItemRecord {
+	records := make([]ItemRecord, 0, len(q))
+	for _, item := range q {
+		records = append(records, item.Record())
+	}
+	return records
+}
+
+// textOutput returns the writer for human readable messages. With JSON output
+// they get written to stderr, so stdout only contains valid JSON.
+func (n *Nuke) textOutput() io.Writer {
+	return textOutput(n.Parameters)
+}
+
+func textOutput(params NukeParameters) io.Writer {
+	if params.Output == OutputJSON {
+		return os.Stderr
+	}
+	return os.Stdout
+}
+
+// PrintItem prints the current state of the item, unless the output is JSON.
+// In that case all items get printed at once by PrintRecords.
+// The item is also hidden, if the log level is above info.
+func (n *Nuke) PrintItem(item *Item) {
+	if n.Parameters.Output == OutputJSON {
+		return
+	}
+	if !log.IsLevelEnabled(log.InfoLevel) {
+		return
+	}
+	if n.pretty() {
+		n.printPretty(item)
+		return
+	}
+	item.Print()
+}
+
+// Phases of the run, in which records get written with JSON output.
+const (
+	PhaseScan   = "scan"
+	PhaseResult = "result"
+	PhaseDiff   = "diff"
+)
+
+// OutputRecord is a single line of the JSON output. Every record is written as
+// a JSON object on its own line (NDJSON), so the output of multiple phases and
+// accounts can be parsed line by line.
+type OutputRecord struct {
+	Account string `json:"account"`
+	Phase   string `json:"phase"`
+	Change  string `json:"change,omitempty"`
+	ItemRecord
+}
+
+// PrintRecords prints all items of the queue as JSON lines, if JSON output is
+// enabled.
+func (n *Nuke) PrintRecords(phase string) {
+	if n.Parameters.Output != OutputJSON {
+		return
+	}
+
+	n.writeRecords(os.Stdout, phase, "", n.items.Records())
+}
+
+func (n *Nuke) writeRecords(w io.Writer, phase, change string, records []ItemRecord) {
+	enc := json.NewEncoder(w)
+	for _, record := range records {
+		err := enc.Encode(OutputRecord{
+			Account:    n.Account.ID(),
+			Phase:      phase,
+			Change:     change,
+			ItemRecord: record,
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "failed to encode items: %v\n", err)
+			return
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+func TestItemRecord(t *testing.T) {
+	region := &Region{Name: "eu-west-1"}
+	queue := Queue{
+		{
+			Region:   region,
+			Type:     "TestResource",
+			State:    ItemStateFiltered,
+			Reason:   "filtered by config",
+			Resource: &testResource{props: types.Properties{"Name": "foo"}},
+		},
+		{
+			Region:   region,
+			Type:     "TestLegacyResource",
+			State:    ItemStateNew,
+			Resource: &testLegacyResource{id: "bar"},
+		},
+	}
+
+	want := []ItemRecord{
+		{
+			Region:     "eu-west-1",
+			Type:       "TestResource",
+			Properties: map[string]string{"Name": "foo"},
+			State:      "filtered",
+			Reason:     "filtered by config",
+		},
+		{
+			Region: "eu-west-1",
+			Type:   "TestLegacyResource",
+			ID:     "bar",
+			State:  "new",
+		},
+	}
+
+	have := queue.Records()
+	if !reflect.DeepEqual(want, have) {
+		t.Errorf("Wrong records. Want: %#v. Have: %#v", want, have)
+	}
+}
+
+func TestWriteRecords(t *testing.T) {
+	n := newTestNuke(nil)
+	records := []ItemRecord{
+		{Region: "eu-west-1", Type: "TestResource", ID: "foo", State: "new"},
+		{Region: "eu-west-1", Type: "TestResource", ID: "bar", State: "filtered"},
+	}
+
+	buf := new(bytes.Buffer)
+	n.writeRecords(buf, PhaseScan, "", records)
+	n.writeRecords(buf, PhaseResult, "", records[:1])
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Wrong number of lines. Want: 3. Got: %d", len(lines))
+	}
+
+	var record OutputRecord
+	err := json.Unmarshal([]byte(lines[2]), &record)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := OutputRecord{Phase: PhaseResult, ItemRecord: records[0]}
+	if !reflect.DeepEqual(want, record) {
+		t.Errorf("Wrong record. Want: %#v. Have: %#v", want, record)
+	}
+}
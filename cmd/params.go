@@ -2,7 +2,12 @@ package cmd
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/notify"
 )
 
 type NukeParameters struct {
@@ -14,9 +19,31 @@ type NukeParameters struct {
 	NoDryRun   bool
 	Force      bool
 	ForceSleep int
+	NoPrompt   bool
 	Quiet      bool
+	Strict     bool
+
+	EstimateCost bool
+	Progress     bool
 
-	MaxWaitRetries int
+	MaxWaitRetries    int
+	MaxFailRetries    int
+	DeadlockPasses    int
+	DeleteTimeout     time.Duration
+	PollInterval      time.Duration
+	ListCacheTTL      time.Duration
+	ScanConcurrency   int
+	DeleteConcurrency int
+	MaxRPS            float64
+	Output            string
+	DryRunReport      string
+	FailureReport     string
+	CompareWith       string
+	MaxDeletions      int
+	StateFile         string
+	MetricsAddr       string
+	NotifyWebhook     string
+	NotifyFormat      string
 }
 
 func (p *NukeParameters) Validate() error {
@@ -24,5 +51,67 @@ func (p *NukeParameters) Validate() error {
 		return fmt.Errorf("You have to specify the --config flag.\n")
 	}
 
+	if p.PollInterval < config.MinPollInterval {
+		return fmt.Errorf("The poll interval must be at least %v.\n", config.MinPollInterval)
+	}
+
+	if p.ScanConcurrency < 1 {
+		return fmt.Errorf("The scan concurrency must be at least 1.\n")
+	}
+
+	if p.Output != OutputText && p.Output != OutputJSON && p.Output != OutputPretty {
+		return fmt.Errorf("The output format must be either '%s', '%s' or '%s'.\n", OutputText, OutputJSON, OutputPretty)
+	}
+
+	if p.MaxDeletions < 0 {
+		return fmt.Errorf("The maximum number of deletions must not be negative.\n")
+	}
+
+	if p.ListCacheTTL < 0 {
+		return fmt.Errorf("The list cache TTL must not be negative.\n")
+	}
+
+	if p.DeleteTimeout < 0 {
+		return fmt.Errorf("The delete timeout must not be negative.\n")
+	}
+
+	if p.MaxFailRetries < 0 {
+		return fmt.Errorf("The maximum number of fail retries must not be negative.\n")
+	}
+
+	if p.DeadlockPasses < 0 {
+		return fmt.Errorf("The number of deadlock passes must not be negative.\n")
+	}
+
+	if p.DeleteConcurrency < 1 {
+		return fmt.Errorf("The delete concurrency must be at least 1.\n")
+	}
+
+	if p.NotifyFormat != notify.FormatGeneric && p.NotifyFormat != notify.FormatSlack {
+		return fmt.Errorf("The notification format must be either '%s' or '%s'.\n", notify.FormatGeneric, notify.FormatSlack)
+	}
+
 	return nil
 }
+
+// ForAccount returns the parameters for one account of a run over several
+// accounts. The paths of the files, which are written or read per account,
+// get the account ID appended, so the accounts don't overwrite each other.
+func (p NukeParameters) ForAccount(accountID string) NukeParameters {
+	p.DryRunReport = AccountPath(p.DryRunReport, accountID)
+	p.FailureReport = AccountPath(p.FailureReport, accountID)
+	p.CompareWith = AccountPath(p.CompareWith, accountID)
+	p.StateFile = AccountPath(p.StateFile, accountID)
+	return p
+}
+
+// AccountPath inserts the account ID before the extension of the path, eg
+// "report.json" becomes "report-000000000000.json". An empty path stays empty.
+func AccountPath(path, accountID string) string {
+	if path == "" {
+		return ""
+	}
+
+	ext := filepath.Ext(path)
+	return fmt.Sprintf("%s-%s%s", strings.TrimSuffix(path, ext), accountID, ext)
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import "testing"
+
+func TestAccountPath(t *testing.T) {
+	cases := []struct {
+		path string
+		want string
+	}{
+		{path: "", want: ""},
+		{path: "report.json", want: "report-000000000000.json"},
+		{path: "out/report.yaml", want: "out/report-000000000000.yaml"},
+		{path: "report", want: "report-000000000000"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.path, func(t *testing.T) {
+			have := AccountPath(tc.path, "000000000000")
+			if have != tc.want {
+				t.Errorf("Wrong path. Want: %s. Got: %s", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestNukeParametersForAccount(t *testing.T) {
+	params := NukeParameters{
+		DryRunReport:  "report.json",
+		FailureReport: "failed.yaml",
+		CompareWith:   "previous.csv",
+		StateFile:     "state.json",
+	}
+
+	have := params.ForAccount("111111111111")
+	if have.DryRunReport != "report-111111111111.json" {
+		t.Errorf("Wrong dry run report: %s", have.DryRunReport)
+	}
+	if have.FailureReport != "failed-111111111111.yaml" {
+		t.Errorf("Wrong failure report: %s", have.FailureReport)
+	}
+	if have.CompareWith != "previous-111111111111.csv" {
+		t.Errorf("Wrong report to compare with: %s", have.CompareWith)
+	}
+	if have.StateFile != "state-111111111111.json" {
+		t.Errorf("Wrong state file: %s", have.StateFile)
+	}
+	if params.DryRunReport != "report.json" {
+		t.Errorf("The original parameters changed: %s", params.DryRunReport)
+	}
+}
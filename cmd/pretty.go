@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// OutputPretty is a human readable output format for interactive use. It
+// colors the states of the items and collapses the waiting items into a
+// status, which gets updated in place after every pass.
+const OutputPretty = "pretty"
+
+// statusLines is the number of lines of the last printed status. It is
+// guarded by logLock and reset by every other printed line, since the status
+// can only be replaced, if it is still the last output.
+var statusLines int
+
+// pretty returns true, if the pretty output should be used. It falls back to
+// the text output, if stdout is no terminal or filtered items are hidden.
+func (n *Nuke) pretty() bool {
+	return n.Parameters.Output == OutputPretty && !n.Parameters.Quiet && !color.NoColor
+}
+
+// printPretty prints the item with the colors of the pretty output. Waiting
+// items are only part of the status.
+func (n *Nuke) printPretty(item *Item) {
+	if item.State == ItemStateWaiting {
+		return
+	}
+	item.print(prettyColors)
+}
+
+// PrintStatus prints the number of waiting items per resource type and the
+// overall progress. With the pretty output it replaces the previous status.
+func (n *Nuke) PrintStatus() {
+	if !n.pretty() {
+		fmt.Fprintln(n.textOutput())
+		fmt.Fprintln(n.textOutput(), n.progress())
+		fmt.Fprintln(n.textOutput())
+		return
+	}
+
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	w := n.textOutput()
+	if statusLines > 0 {
+		// Move the cursor to the beginning of the previous status and
+		// clear everything below.
+		fmt.Fprintf(w, "\033[%dA\033[J", statusLines)
+	}
+
+	lines := waitingStatus(n.items)
+	lines = append(lines, n.progress(), "")
+	fmt.Fprintln(w, strings.Join(lines, "\n"))
+	statusLines = len(lines)
+}
+
+func (n *Nuke) progress() string {
+	return fmt.Sprintf("Removal requested: %d waiting, %d failed, %d filtered, %d removed by dependencies, %d finished",
+		n.items.Count(ItemStateWaiting, ItemStatePending), n.items.Count(ItemStateFailed),
+		n.items.Count(ItemStateFiltered), n.items.Count(ItemStateRemovedByDependency),
+		n.items.Count(ItemStateFinished))
+}
+
+// waitingStatus returns a line for every resource type with waiting items,
+// sorted by the resource type.
+func waitingStatus(items Queue) []string {
+	counts := map[string]int{}
+	for _, item := range items {
+		if item.State == ItemStateWaiting || item.State == ItemStatePending {
+			counts[item.Type]++
+		}
+	}
+
+	types := make([]string, 0, len(counts))
+	for t := range counts {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	lines := make([]string, 0, len(types))
+	for _, t := range types {
+		lines = append(lines, ReasonWaiting.Sprintf("%s - %d waiting", t, counts[t]))
+	}
+	return lines
+}
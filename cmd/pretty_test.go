@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestWaitingStatus(t *testing.T) {
+	noColor := color.NoColor
+	color.NoColor = true
+	defer func() { color.NoColor = noColor }()
+
+	queue := Queue{
+		{Type: "S3Bucket", State: ItemStateWaiting},
+		{Type: "EC2Instance", State: ItemStatePending},
+		{Type: "S3Bucket", State: ItemStateWaiting},
+		{Type: "IAMRole", State: ItemStateFinished},
+	}
+
+	want := []string{"EC2Instance - 1 waiting", "S3Bucket - 2 waiting"}
+	have := waitingStatus(queue)
+	if fmt.Sprint(want) != fmt.Sprint(have) {
+		t.Fatalf("Wrong status. Want: %v. Have: %v", want, have)
+	}
+}
+
+func TestPrettyFallback(t *testing.T) {
+	noColor := color.NoColor
+	defer func() { color.NoColor = noColor }()
+
+	cases := []struct {
+		params  NukeParameters
+		noColor bool
+		want    bool
+	}{
+		{params: NukeParameters{Output: OutputPretty}, want: true},
+		{params: NukeParameters{Output: OutputPretty}, noColor: true, want: false},
+		{params: NukeParameters{Output: OutputPretty, Quiet: true}, want: false},
+		{params: NukeParameters{Output: OutputText}, want: false},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			color.NoColor = tc.noColor
+			n := &Nuke{Parameters: tc.params}
+			if n.pretty() != tc.want {
+				t.Fatalf("Wrong result. Want: %t. Have: %t", tc.want, n.pretty())
+			}
+		})
+	}
+}
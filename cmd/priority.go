@@ -0,0 +1,72 @@
+package cmd
+
+import "sort"
+
+// DefaultDeletionPriorities encodes common dependencies between resource
+// types. Items with a higher priority get removed first within each pass over
+// the queue. Resource types without priority have the priority 0.
+var DefaultDeletionPriorities = map[string]int{
+	// Stacks delete most of their resources on their own.
+	"CloudFormationStack":              100,
+	"ServiceCatalogProvisionedProduct": 100,
+
+	// Compute resources keep network interfaces, volumes and roles in use.
+	"AutoScalingGroup":            90,
+	"EKSNodegroups":               90,
+	"EKSFargateProfiles":          90,
+	"OpsWorksInstance":            90,
+	"ElasticBeanstalkEnvironment": 90,
+	"EC2Instance":                 80,
+	"ECSService":                  80,
+	"LambdaFunction":              80,
+	"RDSInstance":                 80,
+	"NeptuneInstance":             80,
+	"DocDBInstance":               80,
+	"DAXCluster":                  80,
+	"EC2NATGateway":               70,
+	"ELB":                         70,
+	"ELBv2":                       70,
+
+	// Aliases are removed before their keys get scheduled for deletion.
+	"KMSAlias": 10,
+
+	// Batch job queues and compute environments can only be deleted after they
+	// got disabled. Job queues keep their compute environments in use.
+	"BatchJobQueueState":           30,
+	"BatchComputeEnvironmentState": 20,
+	"BatchJobQueue":                10,
+
+	// The delivery channel can only be deleted after the recorder is stopped.
+	"ConfigServiceConfigurationRecorder": 10,
+
+	// Shares and volumes must be deleted before their gateway.
+	"StorageGatewayFileShare": 10,
+	"StorageGatewayVolume":    10,
+
+	// Network resources are used by almost everything else.
+	"EC2SecurityGroup": -50,
+	"EC2Subnet":        -60,
+	"EC2RouteTable":    -60,
+	"EC2VPC":           -70,
+
+	// Roles and policies are required by other resources to clean up.
+	"IAMRolePolicyAttachment": -80,
+	"IAMRole":                 -90,
+}
+
+// SortByPriority orders the queue by the priority of the resource types in
+// descending order. Custom priorities override the default ones. Items with
+// the same priority keep their order.
+func (q Queue) SortByPriority(custom map[string]int) {
+	priority := func(resourceType string) int {
+		p, ok := custom[resourceType]
+		if ok {
+			return p
+		}
+		return DefaultDeletionPriorities[resourceType]
+	}
+
+	sort.SliceStable(q, func(i, j int) bool {
+		return priority(q[i].Type) > priority(q[j].Type)
+	})
+}
@@ -0,0 +1,32 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQueueSortByPriority(t *testing.T) {
+	queue := Queue{
+		{Type: "EC2VPC"},
+		{Type: "S3Bucket"},
+		{Type: "EC2Instance"},
+		{Type: "IAMRole"},
+		{Type: "SQSQueue"},
+		{Type: "CloudFormationStack"},
+	}
+
+	queue.SortByPriority(map[string]int{
+		"SQSQueue":    1000,
+		"EC2Instance": 0,
+	})
+
+	want := []string{"SQSQueue", "CloudFormationStack", "S3Bucket", "EC2Instance", "EC2VPC", "IAMRole"}
+	have := []string{}
+	for _, item := range queue {
+		have = append(have, item.Type)
+	}
+
+	if !reflect.DeepEqual(want, have) {
+		t.Errorf("Wrong order. Want: %v. Have: %v", want, have)
+	}
+}
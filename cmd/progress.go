@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/fatih/color"
+)
+
+const progressBarWidth = 30
+
+// progressLine is the currently shown progress. It is guarded by logLock and
+// gets redrawn below every other line, so it stays at the bottom.
+var progressLine string
+
+// Progress tracks the number of items per state during a pass over the queue.
+// Items are handled concurrently, so it gets updated with the state changes of
+// the handled items instead of counting the queue again.
+type Progress struct {
+	lock   sync.Mutex
+	counts map[ItemState]int
+	total  int
+}
+
+func NewProgress(items Queue) *Progress {
+	p := &Progress{counts: map[ItemState]int{}}
+	for _, item := range items {
+		p.counts[item.State]++
+	}
+	p.total = len(items) - p.counts[ItemStateFiltered]
+	return p
+}
+
+// Update records the state change of an item and returns the new progress.
+func (p *Progress) Update(from, to ItemState) string {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.counts[from]--
+	p.counts[to]++
+
+	return p.String()
+}
+
+func (p *Progress) String() string {
+	finished := p.counts[ItemStateFinished] + p.counts[ItemStateRemovedByDependency]
+	failed := p.counts[ItemStateFailed]
+	remaining := p.counts[ItemStateNew] + p.counts[ItemStatePending] + p.counts[ItemStateWaiting]
+
+	filled := 0
+	percent := 100
+	if p.total > 0 {
+		filled = finished * progressBarWidth / p.total
+		percent = finished * 100 / p.total
+	}
+
+	return fmt.Sprintf("[%s%s] %3d%% %d/%d finished, %d remaining, %d failed",
+		strings.Repeat("=", filled), strings.Repeat(" ", progressBarWidth-filled),
+		percent, finished, p.total, remaining, failed)
+}
+
+// showProgress returns true, if the progress should be shown. It is only
+// shown on a terminal and neither with JSON output nor with --quiet.
+func (n *Nuke) showProgress() bool {
+	return n.Parameters.Progress && !n.Parameters.Quiet &&
+		n.Parameters.Output != OutputJSON && !color.NoColor
+}
+
+// setProgress replaces the shown progress. An empty line removes it.
+func setProgress(line string) {
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	clearProgress()
+	progressLine = line
+	drawProgress()
+}
+
+// clearProgress and drawProgress must be called with the logLock held.
+func clearProgress() {
+	if progressLine != "" {
+		fmt.Print("\r\033[K")
+	}
+}
+
+func drawProgress() {
+	if progressLine != "" {
+		fmt.Print(progressLine)
+	}
+}
@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"testing"
+)
+
+func TestProgress(t *testing.T) {
+	queue := Queue{
+		{State: ItemStateNew},
+		{State: ItemStateNew},
+		{State: ItemStateWaiting},
+		{State: ItemStateFinished},
+		{State: ItemStateFiltered},
+	}
+
+	p := NewProgress(queue)
+
+	want := "[=======                       ]  25% 1/4 finished, 3 remaining, 0 failed"
+	if have := p.String(); have != want {
+		t.Fatalf("Wrong progress.\nWant: %q\nHave: %q", want, have)
+	}
+
+	p.Update(ItemStateNew, ItemStateFailed)
+	want = "[===============               ]  50% 2/4 finished, 1 remaining, 1 failed"
+	if have := p.Update(ItemStateWaiting, ItemStateFinished); have != want {
+		t.Fatalf("Wrong progress.\nWant: %q\nHave: %q", want, have)
+	}
+}
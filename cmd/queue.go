@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"fmt"
+	"time"
 
+	"github.com/fatih/color"
 	"github.com/rebuy-de/aws-nuke/resources"
 )
 
@@ -16,8 +18,30 @@ const (
 	ItemStateFailed
 	ItemStateFiltered
 	ItemStateFinished
+	ItemStateRemovedByDependency
 )
 
+func (s ItemState) String() string {
+	switch s {
+	case ItemStateNew:
+		return "new"
+	case ItemStatePending:
+		return "pending"
+	case ItemStateWaiting:
+		return "waiting"
+	case ItemStateFailed:
+		return "failed"
+	case ItemStateFiltered:
+		return "filtered"
+	case ItemStateFinished:
+		return "finished"
+	case ItemStateRemovedByDependency:
+		return "removed-by-dependency"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
 // An Item describes an actual AWS resource entity with the current state and
 // some metadata.
 type Item struct {
@@ -28,22 +52,55 @@ type Item struct {
 
 	Region *Region
 	Type   string
+
+	// PendingSince is the time of the first successful removal request. It
+	// is used to detect resources, which never finish their removal.
+	PendingSince time.Time
+
+	// abandoned is true, if the removal timed out or got stuck in a deadlock.
+	// Abandoned items are not retried anymore.
+	abandoned bool
+
+	// triggered is true, if a removal of the resource ever succeeded. It
+	// tells resources removed by aws-nuke apart from resources, which got
+	// removed together with a dependency, like their CloudFormation stack.
+	triggered bool
+
+	// EstimatedCost is the approximate monthly cost of the resource in USD.
+	// It is only set with --estimate-cost and if there is a price for it.
+	EstimatedCost *float64
 }
 
 func (i *Item) Print() {
+	i.print(textColors)
+}
+
+func (i *Item) print(colors map[ItemState]color.Color) {
+	c := colors[i.State]
+
 	switch i.State {
 	case ItemStateNew:
-		Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "would remove")
+		msg := "would remove"
+		if i.EstimatedCost != nil {
+			msg = fmt.Sprintf("%s (%s)", msg, formatCost(*i.EstimatedCost))
+		}
+		Log(i.Region, i.Type, i.Resource, c, msg)
 	case ItemStatePending:
-		Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "triggered remove")
+		Log(i.Region, i.Type, i.Resource, c, "triggered remove")
 	case ItemStateWaiting:
-		Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "waiting")
+		Log(i.Region, i.Type, i.Resource, c, "waiting")
 	case ItemStateFailed:
-		Log(i.Region, i.Type, i.Resource, ReasonError, "failed")
+		Log(i.Region, i.Type, i.Resource, c, "failed")
 	case ItemStateFiltered:
-		Log(i.Region, i.Type, i.Resource, ReasonSkip, i.Reason)
+		Log(i.Region, i.Type, i.Resource, c, i.Reason)
 	case ItemStateFinished:
-		Log(i.Region, i.Type, i.Resource, ReasonSuccess, "removed")
+		msg := "removed"
+		if i.Reason != "" {
+			msg = i.Reason
+		}
+		Log(i.Region, i.Type, i.Resource, c, msg)
+	case ItemStateRemovedByDependency:
+		Log(i.Region, i.Type, i.Resource, c, i.Reason)
 	}
 }
 
@@ -108,6 +165,29 @@ func (q Queue) CountTotal() int {
 	return len(q)
 }
 
+// States returns the current state of every item, so it can be compared with
+// Changed after a pass over the queue.
+func (q Queue) States() []ItemState {
+	states := make([]ItemState, len(q))
+	for i, item := range q {
+		states[i] = item.State
+	}
+	return states
+}
+
+// Changed returns true, if any item has another state than before.
+func (q Queue) Changed(before []ItemState) bool {
+	if len(before) != len(q) {
+		return true
+	}
+	for i, item := range q {
+		if item.State != before[i] {
+			return true
+		}
+	}
+	return false
+}
+
 func (q Queue) Count(states ...ItemState) int {
 	count := 0
 	for _, item := range q {
@@ -2,12 +2,96 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/mb0/glob"
 	"github.com/rebuy-de/aws-nuke/pkg/awsutil"
+	"github.com/rebuy-de/aws-nuke/resources"
 )
 
+// RegionAll expands to all enabled regions of the account and the global
+// pseudo region.
+const RegionAll = "all"
+
+// NeedsEnabledRegions returns true, if the configured regions contain "all"
+// or glob patterns, which need to be resolved against the enabled regions.
+func NeedsEnabledRegions(entries []string) bool {
+	for _, entry := range entries {
+		entry = strings.TrimPrefix(entry, "!")
+		if entry == RegionAll || strings.ContainsAny(entry, "*?[") {
+			return true
+		}
+	}
+	return false
+}
+
+// ResolveRegions expands the configured regions. "all" stands for all enabled
+// regions and the global region, glob patterns (eg "eu-*") match enabled
+// regions and entries with a leading "!" exclude the matching regions again.
+// Other entries are kept as they are, so custom regions still work.
+func ResolveRegions(entries []string, enabled []string) ([]string, error) {
+	var (
+		include []string
+		exclude []string
+	)
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry, "!") {
+			exclude = append(exclude, strings.TrimPrefix(entry, "!"))
+			continue
+		}
+
+		switch {
+		case entry == RegionAll:
+			include = append(include, enabled...)
+			include = append(include, awsutil.GlobalRegionID)
+
+		case strings.ContainsAny(entry, "*?["):
+			for _, region := range enabled {
+				match, err := glob.Match(entry, region)
+				if err != nil {
+					return nil, fmt.Errorf("invalid region pattern '%s': %v", entry, err)
+				}
+				if match {
+					include = append(include, region)
+				}
+			}
+
+		default:
+			include = append(include, entry)
+		}
+	}
+
+	seen := map[string]bool{}
+	regions := []string{}
+	for _, region := range include {
+		if seen[region] {
+			continue
+		}
+		seen[region] = true
+
+		excluded := false
+		for _, pattern := range exclude {
+			match, err := glob.Match(pattern, region)
+			if err != nil {
+				return nil, fmt.Errorf("invalid region pattern '!%s': %v", pattern, err)
+			}
+			if match {
+				excluded = true
+				break
+			}
+		}
+
+		if !excluded {
+			regions = append(regions, region)
+		}
+	}
+
+	return regions, nil
+}
+
 // SessionFactory support for custom endpoints
 type SessionFactory func(regionName, svcType string) (*session.Session, error)
 
@@ -33,6 +117,19 @@ func NewRegion(name string, typeResolver ResourceTypeResolver, sessionFactory Se
 	}
 }
 
+// SkipsResourceType returns true, if the resource type must not be listed in
+// the region, because it belongs to a global service and the region is not the
+// global one. Custom regions are not skipped, since they might provide the
+// global services in any region.
+func (region *Region) SkipsResourceType(resourceType string) bool {
+	if !resources.IsGlobal(resourceType) || region.Name == awsutil.GlobalRegionID {
+		return false
+	}
+
+	// The resolver returns "-" for regions of the standard public AWS.
+	return region.ResTypeResolver(region.Name, resourceType) == "-"
+}
+
 func (region *Region) Session(resourceType string) (*session.Session, error) {
 	svcType := region.ResTypeResolver(region.Name, resourceType)
 	if svcType == "" {
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveRegions(t *testing.T) {
+	enabled := []string{"ap-south-1", "eu-central-1", "eu-west-1", "us-east-1"}
+
+	cases := []struct {
+		name    string
+		entries []string
+		want    []string
+	}{
+		{
+			name:    "plain",
+			entries: []string{"eu-west-1", "global"},
+			want:    []string{"eu-west-1", "global"},
+		},
+		{
+			name:    "all",
+			entries: []string{"all"},
+			want:    []string{"ap-south-1", "eu-central-1", "eu-west-1", "us-east-1", "global"},
+		},
+		{
+			name:    "all_without_global",
+			entries: []string{"all", "!global"},
+			want:    []string{"ap-south-1", "eu-central-1", "eu-west-1", "us-east-1"},
+		},
+		{
+			name:    "exclude_pattern",
+			entries: []string{"all", "!ap-*", "!us-east-1"},
+			want:    []string{"eu-central-1", "eu-west-1", "global"},
+		},
+		{
+			name:    "include_pattern",
+			entries: []string{"eu-*", "global"},
+			want:    []string{"eu-central-1", "eu-west-1", "global"},
+		},
+		{
+			name:    "duplicates",
+			entries: []string{"eu-west-1", "eu-*"},
+			want:    []string{"eu-west-1", "eu-central-1"},
+		},
+		{
+			name:    "custom_region",
+			entries: []string{"demo10"},
+			want:    []string{"demo10"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			have, err := ResolveRegions(tc.entries, enabled)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(tc.want, have) {
+				t.Errorf("Wrong regions. Want: %v. Have: %v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestNeedsEnabledRegions(t *testing.T) {
+	cases := []struct {
+		entries []string
+		want    bool
+	}{
+		{entries: []string{"eu-west-1", "global"}, want: false},
+		{entries: []string{"eu-west-1", "!global"}, want: false},
+		{entries: []string{"all"}, want: true},
+		{entries: []string{"eu-*"}, want: true},
+		{entries: []string{"eu-west-1", "!ap-*"}, want: true},
+	}
+
+	for _, tc := range cases {
+		have := NeedsEnabledRegions(tc.entries)
+		if have != tc.want {
+			t.Errorf("Wrong result for %v. Want: %t. Have: %t", tc.entries, tc.want, have)
+		}
+	}
+}
+
+func TestRegionSkipsResourceType(t *testing.T) {
+	standard := func(regionName, resourceType string) string { return "-" }
+	custom := func(regionName, resourceType string) string { return "iam" }
+
+	cases := []struct {
+		name         string
+		region       *Region
+		resourceType string
+		want         bool
+	}{
+		{name: "global_in_region", region: NewRegion("eu-west-1", standard, nil), resourceType: "IAMUser", want: true},
+		{name: "global_in_global", region: NewRegion("global", standard, nil), resourceType: "IAMUser", want: false},
+		{name: "regional_in_region", region: NewRegion("eu-west-1", standard, nil), resourceType: "EC2Instance", want: false},
+		{name: "global_in_custom", region: NewRegion("demo10", custom, nil), resourceType: "IAMUser", want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			have := tc.region.SkipsResourceType(tc.resourceType)
+			if have != tc.want {
+				t.Errorf("Wrong result. Want: %t. Have: %t", tc.want, have)
+			}
+		})
+	}
+}
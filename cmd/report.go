@@ -0,0 +1,198 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// Report is a file based record of a run, so it can be reviewed by others.
+type Report struct {
+	AccountID    string       `json:"account-id" yaml:"account-id"`
+	AccountAlias string       `json:"account-alias,omitempty" yaml:"account-alias,omitempty"`
+	Items        []ItemRecord `json:"items" yaml:"items"`
+}
+
+func (n *Nuke) Report(items Queue) Report {
+	return Report{
+		AccountID:    n.Account.ID(),
+		AccountAlias: n.Account.Alias(),
+		Items:        items.Records(),
+	}
+}
+
+// WriteReport writes the report to the given path. The format is YAML, if
+// the file has a .yaml or .yml extension, CSV for .csv and JSON otherwise.
+func WriteReport(path string, report Report) error {
+	var (
+		raw []byte
+		err error
+	)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		raw, err = yaml.Marshal(report)
+	case ".csv":
+		raw, err = reportCSV(report)
+	default:
+		raw, err = json.MarshalIndent(report, "", "  ")
+	}
+	if err != nil {
+		return errors.Wrap(err, "failed to encode report")
+	}
+
+	err = ioutil.WriteFile(path, raw, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write report to %s", path)
+	}
+
+	return nil
+}
+
+// reportCSV encodes the items of the report with one row per item. The
+// account is part of every row, so the rows of the reports of several
+// accounts can be combined without losing their origin.
+func reportCSV(report Report) ([]byte, error) {
+	buf := new(bytes.Buffer)
+	w := csv.NewWriter(buf)
+
+	w.Write([]string{"account-id", "region", "type", "id", "state", "reason", "properties"})
+	for _, r := range report.Items {
+		w.Write([]string{report.AccountID, r.Region, r.Type, r.ID, r.State, r.Reason, Sorted(r.Properties)})
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// WriteFailureReport writes the failed items to Parameters.FailureReport.
+// It is called at the end of every run, which tried to remove resources, so
+// errors are only logged to keep the result of the run.
+func (n *Nuke) WriteFailureReport() {
+	failed := Queue{}
+	for _, item := range n.items {
+		if item.State == ItemStateFailed {
+			failed = append(failed, item)
+		}
+	}
+
+	err := WriteReport(n.Parameters.FailureReport, n.Report(failed))
+	if err != nil {
+		logrus.Error(err)
+		return
+	}
+
+	fmt.Fprintf(n.textOutput(), "Wrote report of %d failed resources to %s.\n", len(failed), n.Parameters.FailureReport)
+}
+
+// ReadReport reads a report, which was written by WriteReport.
+func ReadReport(path string) (Report, error) {
+	var report Report
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return report, errors.Wrapf(err, "failed to read report from %s", path)
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(raw, &report)
+	case ".csv":
+		err = fmt.Errorf("CSV reports are not supported")
+	default:
+		err = json.Unmarshal(raw, &report)
+	}
+	if err != nil {
+		return report, errors.Wrapf(err, "failed to decode report %s", path)
+	}
+
+	return report, nil
+}
+
+// ReportDiff contains the changes between two reports.
+type ReportDiff struct {
+	NewlyInScope    []ItemRecord `json:"newly-in-scope"`
+	NewlyFiltered   []ItemRecord `json:"newly-filtered"`
+	NoLongerPresent []ItemRecord `json:"no-longer-present"`
+}
+
+// CompareReports returns the resources, which would be deleted now but were
+// not before, which are filtered now but were not before, and which were
+// scanned before but do not exist anymore.
+func CompareReports(previous, current Report) ReportDiff {
+	diff := ReportDiff{
+		NewlyInScope:    []ItemRecord{},
+		NewlyFiltered:   []ItemRecord{},
+		NoLongerPresent: []ItemRecord{},
+	}
+
+	previousStates := map[string]string{}
+	for _, record := range previous.Items {
+		previousStates[record.Key()] = record.State
+	}
+
+	currentKeys := map[string]bool{}
+	for _, record := range current.Items {
+		key := record.Key()
+		currentKeys[key] = true
+
+		previousState, ok := previousStates[key]
+		if ok && previousState == record.State {
+			continue
+		}
+
+		switch record.State {
+		case ItemStateNew.String():
+			diff.NewlyInScope = append(diff.NewlyInScope, record)
+		case ItemStateFiltered.String():
+			diff.NewlyFiltered = append(diff.NewlyFiltered, record)
+		}
+	}
+
+	for _, record := range previous.Items {
+		if !currentKeys[record.Key()] {
+			diff.NoLongerPresent = append(diff.NoLongerPresent, record)
+		}
+	}
+
+	return diff
+}
+
+// PrintReportDiff prints the changes compared to a previous report, either as
+// text or as JSON lines with the kind of change.
+func (n *Nuke) PrintReportDiff(diff ReportDiff) {
+	if n.Parameters.Output == OutputJSON {
+		n.writeRecords(os.Stdout, PhaseDiff, "newly-in-scope", diff.NewlyInScope)
+		n.writeRecords(os.Stdout, PhaseDiff, "newly-filtered", diff.NewlyFiltered)
+		n.writeRecords(os.Stdout, PhaseDiff, "no-longer-present", diff.NoLongerPresent)
+		return
+	}
+
+	w := n.textOutput()
+	fmt.Fprintf(w, "Changes compared to %s:\n", n.Parameters.CompareWith)
+	printRecords(w, "newly in scope", diff.NewlyInScope)
+	printRecords(w, "newly filtered", diff.NewlyFiltered)
+	printRecords(w, "no longer present", diff.NoLongerPresent)
+	fmt.Fprintln(w)
+}
+
+func printRecords(w io.Writer, title string, records []ItemRecord) {
+	fmt.Fprintf(w, "  %s: %d\n", title, len(records))
+	for _, record := range records {
+		id := record.ID
+		if id == "" {
+			id = Sorted(record.Properties)
+		}
+		fmt.Fprintf(w, "    %s - %s - %s\n", record.Region, record.Type, id)
+	}
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+	"gopkg.in/yaml.v2"
+)
+
+func TestWriteReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-nuke-report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	want := Report{
+		AccountID: "555133742",
+		Items: []ItemRecord{
+			{Region: "eu-west-1", Type: "IAMUser", ID: "admin", State: "filtered", Reason: "filtered by config"},
+			{Region: "eu-west-1", Type: "S3Bucket", Properties: map[string]string{"Name": "foo"}, State: "new"},
+		},
+	}
+
+	cases := []struct {
+		file      string
+		unmarshal func([]byte, interface{}) error
+	}{
+		{file: "report.json", unmarshal: json.Unmarshal},
+		{file: "report.yaml", unmarshal: yaml.Unmarshal},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.file, func(t *testing.T) {
+			path := filepath.Join(dir, tc.file)
+
+			err := WriteReport(path, want)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			raw, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var have Report
+			err = tc.unmarshal(raw, &have)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(want, have) {
+				t.Errorf("Wrong report. Want: %#v. Have: %#v", want, have)
+			}
+		})
+	}
+}
+
+func TestWriteReportFailure(t *testing.T) {
+	err := WriteReport("/non-existing-directory/report.json", Report{})
+	if err == nil {
+		t.Fatal("Expected an error but didn't get one.")
+	}
+}
+
+func TestWriteFailureReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-nuke-report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "failed.csv")
+	region := &Region{Name: "eu-west-1"}
+
+	n := &Nuke{Parameters: NukeParameters{FailureReport: path}}
+	n.items = Queue{
+		{
+			Region:   region,
+			Type:     "S3Bucket",
+			State:    ItemStateFailed,
+			Reason:   "BucketNotEmpty",
+			Resource: &testResource{props: types.Properties{"Name": "foo"}},
+		},
+		{
+			Region:   region,
+			Type:     "IAMUser",
+			State:    ItemStateFinished,
+			Resource: &testLegacyResource{id: "admin"},
+		},
+	}
+
+	n.WriteFailureReport()
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "account-id,region,type,id,state,reason,properties\n" +
+		",eu-west-1,S3Bucket,,failed,BucketNotEmpty,\"[Name: \"\"foo\"\"]\"\n"
+	if string(raw) != want {
+		t.Errorf("Wrong report.\nWant: %q\nHave: %q", want, string(raw))
+	}
+}
+
+func TestReadReport(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-nuke-report")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	want := Report{
+		AccountID: "555133742",
+		Items: []ItemRecord{
+			{Region: "eu-west-1", Type: "IAMUser", ID: "admin", State: "filtered"},
+		},
+	}
+
+	for _, file := range []string{"report.json", "report.yml"} {
+		t.Run(file, func(t *testing.T) {
+			path := filepath.Join(dir, file)
+
+			err := WriteReport(path, want)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			have, err := ReadReport(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(want, have) {
+				t.Errorf("Wrong report. Want: %#v. Have: %#v", want, have)
+			}
+		})
+	}
+}
+
+func TestCompareReports(t *testing.T) {
+	previous := Report{Items: []ItemRecord{
+		{Region: "eu-west-1", Type: "IAMUser", ID: "admin", State: "new"},
+		{Region: "eu-west-1", Type: "IAMUser", ID: "ci", State: "filtered"},
+		{Region: "eu-west-1", Type: "S3Bucket", ID: "s3://old", State: "new"},
+		{Region: "eu-west-1", Type: "S3Object", Properties: map[string]string{"Key": "a"}, State: "new"},
+	}}
+	current := Report{Items: []ItemRecord{
+		{Region: "eu-west-1", Type: "IAMUser", ID: "admin", State: "filtered"},
+		{Region: "eu-west-1", Type: "IAMUser", ID: "ci", State: "new"},
+		{Region: "eu-west-1", Type: "S3Bucket", ID: "s3://new", State: "new"},
+		{Region: "eu-west-1", Type: "S3Object", Properties: map[string]string{"Key": "a"}, State: "new"},
+	}}
+
+	want := ReportDiff{
+		NewlyInScope: []ItemRecord{
+			{Region: "eu-west-1", Type: "IAMUser", ID: "ci", State: "new"},
+			{Region: "eu-west-1", Type: "S3Bucket", ID: "s3://new", State: "new"},
+		},
+		NewlyFiltered: []ItemRecord{
+			{Region: "eu-west-1", Type: "IAMUser", ID: "admin", State: "filtered"},
+		},
+		NoLongerPresent: []ItemRecord{
+			{Region: "eu-west-1", Type: "S3Bucket", ID: "s3://old", State: "new"},
+		},
+	}
+
+	have := CompareReports(previous, current)
+	if !reflect.DeepEqual(want, have) {
+		t.Errorf("Wrong diff. Want: %#v. Have: %#v", want, have)
+	}
+}
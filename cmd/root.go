@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/rebuy-de/aws-nuke/pkg/awsutil"
 	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/resources"
@@ -14,10 +16,14 @@ import (
 
 func NewRootCommand() *cobra.Command {
 	var (
-		params        NukeParameters
-		creds         awsutil.Credentials
-		defaultRegion string
-		verbose       bool
+		params         NukeParameters
+		creds          awsutil.Credentials
+		assumeRoleArns []string
+		protected      []string
+		defaultRegion  string
+		verbose        bool
+		logLevel       string
+		logFormat      string
 	)
 
 	command := &cobra.Command{
@@ -26,14 +32,28 @@ func NewRootCommand() *cobra.Command {
 		Long:  `A tool which removes every resource from an AWS account.  Use it with caution, since it cannot distinguish between production and non-production.`,
 	}
 
-	command.PreRun = func(cmd *cobra.Command, args []string) {
-		log.SetLevel(log.InfoLevel)
+	command.PreRunE = func(cmd *cobra.Command, args []string) error {
+		level, err := log.ParseLevel(logLevel)
+		if err != nil {
+			return err
+		}
 		if verbose {
-			log.SetLevel(log.DebugLevel)
+			level = log.DebugLevel
 		}
-		log.SetFormatter(&log.TextFormatter{
-			EnvironmentOverrideColors: true,
-		})
+		log.SetLevel(level)
+
+		switch logFormat {
+		case "text":
+			log.SetFormatter(&log.TextFormatter{
+				EnvironmentOverrideColors: true,
+			})
+		case "json":
+			log.SetFormatter(&log.JSONFormatter{})
+		default:
+			return fmt.Errorf("The log format must be either 'text' or 'json'.\n")
+		}
+
+		return nil
 	}
 
 	command.RunE = func(cmd *cobra.Command, args []string) error {
@@ -48,11 +68,6 @@ func NewRootCommand() *cobra.Command {
 			creds.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
 			creds.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
 		}
-		err = creds.Validate()
-		if err != nil {
-			return err
-		}
-
 		command.SilenceUsage = true
 
 		config, err := config.Load(params.ConfigPath)
@@ -61,6 +76,10 @@ func NewRootCommand() *cobra.Command {
 			return err
 		}
 
+		if !cmd.Flags().Changed("poll-interval") && config.PollInterval != 0 {
+			params.PollInterval = config.PollInterval
+		}
+
 		if defaultRegion != "" {
 			awsutil.DefaultRegionID = defaultRegion
 			if config.CustomEndpoints.GetRegion(defaultRegion) == nil {
@@ -70,21 +89,22 @@ func NewRootCommand() *cobra.Command {
 			}
 		}
 
-		account, err := awsutil.NewAccount(creds, config.CustomEndpoints)
-		if err != nil {
-			return err
-		}
-
-		n := NewNuke(params, *account)
+		creds.RateLimiter = awsutil.NewRateLimiter(params.MaxRPS, config.RateLimits)
+		config.ProtectedAccounts = append(config.ProtectedAccounts, protected...)
 
-		n.Config = config
-
-		return n.Run()
+		return NukeAccounts(params, creds, config, assumeRoleArns)
 	}
 
 	command.PersistentFlags().BoolVarP(
 		&verbose, "verbose", "v", false,
-		"Enables debug output.")
+		"Enables debug output. Same as --log-level debug.")
+	command.PersistentFlags().StringVar(
+		&logLevel, "log-level", "info",
+		"Minimum level of log messages (eg 'debug', 'info', 'warn', 'error'). "+
+			"Levels above 'info' also hide the output of each resource.")
+	command.PersistentFlags().StringVar(
+		&logFormat, "log-format", "text",
+		"Format of log messages, which are written to stderr. Either 'text' or 'json'.")
 
 	command.PersistentFlags().StringVarP(
 		&params.ConfigPath, "config", "c", "",
@@ -92,7 +112,8 @@ func NewRootCommand() *cobra.Command {
 
 	command.PersistentFlags().StringVar(
 		&creds.Profile, "profile", "",
-		"Name of the AWS profile name for accessing the AWS API. "+
+		"Name of the AWS profile name for accessing the AWS API. Supports SSO and "+
+			"credential_process profiles of the shared config file. "+
 			"Cannot be used together with --access-key-id and --secret-access-key.")
 	command.PersistentFlags().StringVar(
 		&creds.AccessKeyID, "access-key-id", "",
@@ -109,18 +130,60 @@ func NewRootCommand() *cobra.Command {
 		"AWS session token for accessing the AWS API. "+
 			"Must be used together with --access-key-id and --secret-access-key. "+
 			"Cannot be used together with --profile.")
+	command.PersistentFlags().StringSliceVar(
+		&assumeRoleArns, "assume-role-arn", []string{},
+		"AWS IAM role arn to assume. "+
+			"The credentials provided via --access-key-id or --profile must "+
+			"be allowed to assume this role. "+
+			"This flag can be used multiple times to nuke multiple accounts.")
+	command.PersistentFlags().StringSliceVar(
+		&protected, "protect-account", []string{},
+		"Account ID, which must never be nuked, even if one of the assumed roles belongs to it. "+
+			"Adds to 'protected-accounts' of the config. This flag can be used multiple times.")
+	command.PersistentFlags().StringVar(
+		&creds.ExternalID, "external-id", "",
+		"External ID to use when assuming the role of --assume-role-arn.")
+	command.PersistentFlags().StringVar(
+		&creds.RoleSessionName, "role-session-name", "",
+		"Session name to use when assuming the role of --assume-role-arn. "+
+			"Defaults to a timestamp.")
 	command.PersistentFlags().StringVar(
 		&defaultRegion, "default-region", "",
 		"Custom default region name.")
+	command.PersistentFlags().StringVar(
+		&creds.EndpointURL, "endpoint-url", "",
+		"Custom endpoint URL for all AWS services (eg http://localhost:4566 for LocalStack).")
+	command.PersistentFlags().BoolVar(
+		&creds.TLSInsecureSkipVerify, "tls-insecure-skip-verify", false,
+		"Disables the TLS certificate verification of --endpoint-url. "+
+			"Only use this for local testing.")
+	command.PersistentFlags().IntVar(
+		&creds.MaxRetries, "max-retries", client.DefaultRetryerMaxNumRetries,
+		"Number of retries for AWS API requests, which failed because of throttling, "+
+			"timeouts or server errors.")
+	command.PersistentFlags().DurationVar(
+		&creds.RetryBaseDelay, "retry-base-delay", 0,
+		"Initial delay of the exponential backoff between retries of AWS API requests. "+
+			"Defaults to the AWS SDK defaults of 30ms and 500ms for throttling errors.")
 
 	command.PersistentFlags().StringSliceVarP(
 		&params.Targets, "target", "t", []string{},
-		"Limit nuking to certain resource types (eg IAMServerCertificate). "+
-			"This flag can be used multiple times.")
+		"Limit nuking to certain resource types (eg IAMServerCertificate), services (eg ec2) "+
+			"or groups from 'resource-type-groups' in the config. This flag can be used multiple times.")
 	command.PersistentFlags().StringSliceVarP(
 		&params.Excludes, "exclude", "e", []string{},
-		"Prevent nuking of certain resource types (eg IAMServerCertificate). "+
-			"This flag can be used multiple times.")
+		"Prevent nuking of certain resource types (eg IAMServerCertificate), services (eg ec2) "+
+			"or groups from 'resource-type-groups' in the config. This flag can be used multiple times.")
+	command.PersistentFlags().BoolVar(
+		&params.EstimateCost, "estimate-cost", false,
+		"Print the approximate monthly cost of nukeable resources, based on a built-in price table.")
+	command.PersistentFlags().BoolVar(
+		&params.Progress, "progress", false,
+		"Show the progress of the removal in place, if stdout is a terminal.")
+	command.PersistentFlags().BoolVar(
+		&params.Strict, "strict", false,
+		"Fail instead of warning, if targets or excludes contain unknown resource types "+
+			"or filters reference properties, which no scanned resource reports.")
 	command.PersistentFlags().BoolVar(
 		&params.NoDryRun, "no-dry-run", false,
 		"If specified, it actually deletes found resources. "+
@@ -129,20 +192,99 @@ func NewRootCommand() *cobra.Command {
 		&params.Force, "force", false,
 		"Don't ask for confirmation before deleting resources. "+
 			"Instead it waits 15s before continuing. Set --force-sleep to change the wait time.")
+	command.PersistentFlags().BoolVar(
+		&params.NoPrompt, "no-prompt", false,
+		"Don't ask for confirmation and don't wait before deleting resources. Intended for automation.")
 	command.PersistentFlags().IntVar(
 		&params.ForceSleep, "force-sleep", 15,
-		"If specified and --force is set, wait this many seconds before deleting resources. "+
-			"Defaults to 15.")
+		"If specified and --force is set, wait this many seconds before deleting resources, "+
+			"unless stdin is no terminal. Defaults to 15.")
+	command.PersistentFlags().IntVar(
+		&params.MaxFailRetries, "max-fail-retries", 2,
+		"Number of additional passes over the queue, when there are only failed resources left, "+
+			"before giving up. Increase it for accounts, which need longer to converge.")
 	command.PersistentFlags().IntVar(
 		&params.MaxWaitRetries, "max-wait-retries", 0,
 		"If specified, the program will exit if resources are stuck in waiting for this many iterations. "+
 			"0 (default) disables early exit.")
+	command.PersistentFlags().IntVar(
+		&params.DeadlockPasses, "deadlock-passes", 0,
+		"If specified, resources which are still waiting or failing after this many consecutive passes "+
+			"without any state change are marked as failed with a deadlock and not retried. "+
+			"Passes which reused a cached listing do not count. "+
+			"0 (default) disables the detection.")
+	command.PersistentFlags().DurationVar(
+		&params.ListCacheTTL, "list-cache-ttl", 30*time.Second,
+		"Time for which the listed resources of a type are reused to check whether resources are "+
+			"removed. Resource types with newly triggered removals are always listed again. "+
+			"0 lists them again in every pass.")
+	command.PersistentFlags().DurationVar(
+		&params.DeleteTimeout, "delete-timeout", 0,
+		"If specified, resources which are still waiting for their removal after this duration are "+
+			"marked as failed and not retried. Can be overridden per resource type with "+
+			"'delete-timeouts' in the config file. 0 (default) disables the timeout.")
+	command.PersistentFlags().DurationVar(
+		&params.PollInterval, "poll-interval", 5*time.Second,
+		"Time to wait between two passes over the removal queue. "+
+			"Must be at least 1s. Overrides 'poll-interval' in the config file.")
+	command.PersistentFlags().IntVar(
+		&params.ScanConcurrency, "scan-concurrency", 4,
+		"Number of regions which get scanned at the same time.")
+	command.PersistentFlags().IntVar(
+		&params.DeleteConcurrency, "delete-concurrency", 1,
+		"Number of resources which get removed at the same time. "+
+			"Defaults to 1, which removes them one after another.")
+	command.PersistentFlags().Float64Var(
+		&params.MaxRPS, "max-rps", 0,
+		"Maximum number of requests per second sent to the AWS API. "+
+			"Can be overridden per service with 'rate-limits' in the config file. "+
+			"0 (default) means unlimited.")
+	command.PersistentFlags().IntVar(
+		&params.MaxDeletions, "max-deletions", 0,
+		"If specified, the program aborts before removing anything, if there are more nukeable resources. "+
+			"0 (default) means unlimited.")
+	command.PersistentFlags().StringVar(
+		&params.StateFile, "state-file", "",
+		"If specified, the progress of the removal gets stored in this file, so an interrupted "+
+			"run can be resumed. The file gets removed after a successful run. With several accounts, "+
+			"the account ID is appended to the file name.")
+	command.PersistentFlags().StringVar(
+		&params.MetricsAddr, "metrics-addr", "",
+		"If specified, serves Prometheus metrics about the progress on this address (eg ':9090').")
+	command.PersistentFlags().StringVar(
+		&params.NotifyWebhook, "notify-webhook", "",
+		"If specified, posts a summary of the run on each account to this URL. "+
+			"Failing to post it does not fail the run.")
+	command.PersistentFlags().StringVar(
+		&params.NotifyFormat, "notify-format", "generic",
+		"The payload format of --notify-webhook. Either 'generic' or 'slack'.")
 	command.PersistentFlags().BoolVarP(
 		&params.Quiet, "quiet", "q", false,
 		"Don't show filtered resources.")
+	command.PersistentFlags().StringVarP(
+		&params.Output, "output", "o", OutputText,
+		"Output format of the scanned and removed resources. Either 'text', 'json' or 'pretty'. "+
+			"With 'json' all other messages are written to stderr. 'pretty' colors the states and "+
+			"summarizes waiting resources, if stdout is a terminal.")
+	command.PersistentFlags().StringVar(
+		&params.DryRunReport, "dry-run-report", "",
+		"If specified and the run is a dry run, writes all scanned resources including the "+
+			"filtered ones to this file. Uses YAML for .yaml and .yml files, CSV for .csv files "+
+			"and JSON otherwise. With several accounts, the account ID is appended to the file name.")
+	command.PersistentFlags().StringVar(
+		&params.FailureReport, "failure-report", "",
+		"If specified, writes all resources, which could not be removed, to this file at the end "+
+			"of the run. The format and the file name with several accounts are the same as with "+
+			"--dry-run-report.")
+	command.PersistentFlags().StringVar(
+		&params.CompareWith, "compare-with", "",
+		"If specified, loads a previous dry run report and prints which resources are newly in "+
+			"scope, newly filtered or no longer present. With several accounts, the account ID is "+
+			"appended to the file name.")
 
 	command.AddCommand(NewVersionCommand())
 	command.AddCommand(NewResourceTypesCommand())
+	command.AddCommand(NewValidateConfigCommand(&params))
 
 	return command
 }
@@ -14,12 +14,12 @@ import (
 
 const ScannerParallelQueries = 16
 
-func Scan(region *Region, resourceTypes []string) <-chan *Item {
+func Scan(ctx context.Context, region *Region, resourceTypes []string) <-chan *Item {
 	s := &scanner{
 		items:     make(chan *Item, 100),
 		semaphore: semaphore.NewWeighted(ScannerParallelQueries),
 	}
-	go s.run(region, resourceTypes)
+	go s.run(ctx, region, resourceTypes)
 
 	return s.items
 }
@@ -29,16 +29,23 @@ type scanner struct {
 	semaphore *semaphore.Weighted
 }
 
-func (s *scanner) run(region *Region, resourceTypes []string) {
-	ctx := context.Background()
-
+// run lists all resource types of the region. It stops listing further
+// resource types, after the context got cancelled, but still waits for the
+// running listers before closing the channel.
+func (s *scanner) run(ctx context.Context, region *Region, resourceTypes []string) {
 	for _, resourceType := range resourceTypes {
-		s.semaphore.Acquire(ctx, 1)
+		if region.SkipsResourceType(resourceType) {
+			continue
+		}
+
+		if ctx.Err() != nil || s.semaphore.Acquire(ctx, 1) != nil {
+			break
+		}
 		go s.list(region, resourceType)
 	}
 
 	// Wait for all routines to finish.
-	s.semaphore.Acquire(ctx, ScannerParallelQueries)
+	s.semaphore.Acquire(context.Background(), ScannerParallelQueries)
 
 	close(s.items)
 }
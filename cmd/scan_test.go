@@ -0,0 +1,21 @@
+package cmd
+
+import (
+	"context"
+	"testing"
+)
+
+func TestScanCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	region := NewRegion("eu-west-1", nil, nil)
+	count := 0
+	for range Scan(ctx, region, []string{"EC2Instance", "S3Bucket"}) {
+		count++
+	}
+
+	if count != 0 {
+		t.Errorf("Listed %d items after the scan got cancelled.", count)
+	}
+}
@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// State is the persisted progress of a run, which allows resuming it after
+// the process got interrupted.
+type State struct {
+	AccountID string        `json:"account-id"`
+	Regions   []string      `json:"regions"`
+	Items     []StateRecord `json:"items"`
+}
+
+// StateRecord is an item of the state file. Besides the record it contains
+// the time the removal of the resource got triggered, so the delete timeout
+// keeps counting after resuming.
+type StateRecord struct {
+	ItemRecord
+	PendingSince *time.Time `json:"pending-since,omitempty"`
+}
+
+// LoadState reads the state file from the given path. It returns nil without
+// an error, if the file does not exist.
+func LoadState(path string) (*State, error) {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read state file %s", path)
+	}
+
+	state := new(State)
+	err = json.Unmarshal(raw, state)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse state file %s", path)
+	}
+
+	return state, nil
+}
+
+// SaveState writes the current queue to the state file, if one is configured.
+// The file gets replaced atomically, so an interruption while writing does not
+// corrupt it.
+func (n *Nuke) SaveState() error {
+	path := n.Parameters.StateFile
+	if path == "" {
+		return nil
+	}
+
+	state := State{
+		AccountID: n.Account.ID(),
+		Regions:   n.Config.Regions,
+		Items:     make([]StateRecord, 0, len(n.items)),
+	}
+	for _, item := range n.items {
+		record := StateRecord{ItemRecord: item.Record()}
+		if !item.PendingSince.IsZero() {
+			pendingSince := item.PendingSince
+			record.PendingSince = &pendingSince
+		}
+		state.Items = append(state.Items, record)
+	}
+
+	raw, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to encode state")
+	}
+
+	tmp := path + ".tmp"
+	err = ioutil.WriteFile(tmp, raw, 0644)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write state file %s", tmp)
+	}
+
+	return errors.Wrapf(os.Rename(tmp, path), "failed to write state file %s", path)
+}
+
+// RemoveState deletes the state file, since there is nothing left to resume.
+func (n *Nuke) RemoveState() error {
+	path := n.Parameters.StateFile
+	if path == "" {
+		return nil
+	}
+
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "failed to remove state file %s", path)
+	}
+	return nil
+}
+
+// LoadResumeState loads the state file before the scan, so only the resource
+// types with resources left get scanned again. The state is ignored, if the
+// regions changed, and rejected, if it belongs to another account.
+func (n *Nuke) LoadResumeState() error {
+	if n.Parameters.StateFile == "" {
+		return nil
+	}
+
+	state, err := LoadState(n.Parameters.StateFile)
+	if err != nil {
+		return err
+	}
+	if state == nil {
+		return nil
+	}
+
+	if state.AccountID != n.Account.ID() {
+		return fmt.Errorf("The state file %s belongs to the account %s, but the current account is %s.",
+			n.Parameters.StateFile, state.AccountID, n.Account.ID())
+	}
+
+	if !reflect.DeepEqual(state.Regions, n.Config.Regions) {
+		log.Warnf("The regions changed since the state file %s was written. Ignoring it.",
+			n.Parameters.StateFile)
+		return nil
+	}
+
+	n.resumeState = state
+	return nil
+}
+
+// resumeTypes returns the resource types, which need to be scanned in the
+// region. Without a state all types get scanned. Otherwise only the types,
+// which still had resources left in the previous run, get scanned.
+func (n *Nuke) resumeTypes(region string, resourceTypes []string) []string {
+	if n.resumeState == nil {
+		return resourceTypes
+	}
+
+	left := map[string]bool{}
+	for _, record := range n.resumeState.Items {
+		if record.Region == region && !isDone(record.State) {
+			left[record.Type] = true
+		}
+	}
+
+	result := []string{}
+	for _, resourceType := range resourceTypes {
+		if left[resourceType] {
+			result = append(result, resourceType)
+		}
+	}
+	return result
+}
+
+// isDone returns true for the states, which do not need any further action.
+func isDone(state string) bool {
+	switch state {
+	case ItemStateFinished.String(), ItemStateFiltered.String(), ItemStateRemovedByDependency.String():
+		return true
+	default:
+		return false
+	}
+}
+
+// ResumeState applies the states of the loaded state file to the freshly
+// scanned items. Resources, whose removal was already triggered in the
+// previous run, only get waited for instead of removed again. Resources,
+// which were already done and therefore not scanned again, get restored from
+// the state file, so they are part of the summary.
+func (n *Nuke) ResumeState() error {
+	state := n.resumeState
+	if state == nil {
+		return nil
+	}
+
+	previous := map[string]StateRecord{}
+	for _, record := range state.Items {
+		previous[record.Key()] = record
+	}
+
+	scanned := map[string]bool{}
+	resumed := 0
+	for _, item := range n.items {
+		key := item.Record().Key()
+		scanned[key] = true
+
+		if item.State != ItemStateNew {
+			continue
+		}
+
+		record, ok := previous[key]
+		if !ok {
+			continue
+		}
+
+		switch record.State {
+		case ItemStatePending.String(), ItemStateWaiting.String(), ItemStateFinished.String():
+			item.State = ItemStatePending
+			item.triggered = true
+			item.PendingSince = time.Now()
+			if record.PendingSince != nil {
+				item.PendingSince = *record.PendingSince
+			}
+			resumed++
+		}
+	}
+
+	restored := 0
+	for _, record := range state.Items {
+		if !isDone(record.State) || scanned[record.Key()] {
+			continue
+		}
+
+		n.items = append(n.items, n.restoreItem(record.ItemRecord))
+		restored++
+	}
+
+	fmt.Fprintf(n.textOutput(), "Resuming from %s: %d resources restored without scanning, %d removals in progress.\n\n",
+		n.Parameters.StateFile, restored, resumed)
+
+	return nil
+}
+
+// restoreItem creates an item for a resource of the state file, which was not
+// scanned again.
+func (n *Nuke) restoreItem(record ItemRecord) *Item {
+	state := ItemStateFinished
+	for _, s := range []ItemState{ItemStateFiltered, ItemStateRemovedByDependency} {
+		if record.State == s.String() {
+			state = s
+		}
+	}
+
+	return &Item{
+		Resource: &restoredResource{record: record},
+		State:    state,
+		Reason:   record.Reason,
+		Region:   NewRegion(record.Region, n.Account.ResourceTypeToServiceType, n.Account.NewSession),
+		Type:     record.Type,
+	}
+}
+
+// restoredResource stands in for a resource of the state file, which was
+// already removed or filtered in the previous run. It only provides the ID
+// and properties of the record.
+type restoredResource struct {
+	record ItemRecord
+}
+
+func (r *restoredResource) Remove() error {
+	return fmt.Errorf("%s was restored from the state file and cannot be removed", r.record.Key())
+}
+
+func (r *restoredResource) Properties() types.Properties {
+	return types.Properties(r.record.Properties)
+}
+
+func (r *restoredResource) String() string {
+	return r.record.ID
+}
+
+// Key identifies the resource of the record across multiple runs. Resources
+// with an ID are identified by it, since properties like tags might change
+// between the runs. Other resources are identified by their properties.
+func (r ItemRecord) Key() string {
+	if r.ID != "" {
+		return fmt.Sprintf("%s/%s/%s", r.Region, r.Type, r.ID)
+	}
+	return fmt.Sprintf("%s/%s/%s", r.Region, r.Type, Sorted(r.Properties))
+}
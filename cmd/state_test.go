@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+func TestStateResume(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-nuke-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	region := &Region{Name: "eu-west-1"}
+	newQueue := func(states ...ItemState) Queue {
+		queue := Queue{}
+		for i, state := range states {
+			queue = append(queue, &Item{
+				Region:   region,
+				Type:     "TestResource",
+				State:    state,
+				Resource: &testResource{props: types.Properties{"Index": string(rune('a' + i))}},
+			})
+		}
+		return queue
+	}
+
+	n := &Nuke{
+		Parameters: NukeParameters{StateFile: filepath.Join(dir, "state.json")},
+		Config:     &config.Nuke{Regions: []string{"eu-west-1"}},
+	}
+
+	pendingSince := time.Now().Add(-time.Hour).Round(time.Second)
+	n.items = newQueue(ItemStateWaiting, ItemStateFailed, ItemStatePending, ItemStateFinished)
+	n.items[0].PendingSince = pendingSince
+	err = n.SaveState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = n.LoadResumeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n.items = newQueue(ItemStateNew, ItemStateNew, ItemStateNew, ItemStateNew, ItemStateNew)
+	err = n.ResumeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []ItemState{ItemStatePending, ItemStateNew, ItemStatePending, ItemStatePending, ItemStateNew}
+	for i, item := range n.items {
+		if item.State != want[i] {
+			t.Errorf("Wrong state of item %d. Want: %v. Have: %v", i, want[i], item.State)
+		}
+		if item.State == ItemStatePending && (!item.triggered || item.PendingSince.IsZero()) {
+			t.Errorf("Resumed item %d is not marked as triggered.", i)
+		}
+	}
+	if !n.items[0].PendingSince.Equal(pendingSince) {
+		t.Errorf("Wrong pending since. Want: %v. Have: %v", pendingSince, n.items[0].PendingSince)
+	}
+
+	err = n.RemoveState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := LoadState(n.Parameters.StateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state != nil {
+		t.Errorf("State file was not removed.")
+	}
+}
+
+func TestStateResumeIgnoresChangedRegions(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-nuke-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	item := &Item{
+		Region:   &Region{Name: "eu-west-1"},
+		Type:     "TestResource",
+		State:    ItemStatePending,
+		Resource: &testResource{props: types.Properties{"Name": "foo"}},
+	}
+
+	n := &Nuke{
+		Parameters: NukeParameters{StateFile: filepath.Join(dir, "state.json")},
+		Config:     &config.Nuke{Regions: []string{"eu-west-1"}},
+		items:      Queue{item},
+	}
+
+	err = n.SaveState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n.Config.Regions = []string{"eu-west-1", "eu-central-1"}
+	item.State = ItemStateNew
+
+	err = n.LoadResumeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.resumeState != nil {
+		t.Errorf("State file with different regions was loaded.")
+	}
+
+	err = n.ResumeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.State != ItemStateNew {
+		t.Errorf("State file with different regions was not ignored.")
+	}
+}
+
+func TestStateResumeRestoresDoneItems(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-nuke-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	newItem := func(region, resourceType, name string, state ItemState) *Item {
+		return &Item{
+			Region:   &Region{Name: region},
+			Type:     resourceType,
+			State:    state,
+			Resource: &testResource{props: types.Properties{"Name": name}},
+		}
+	}
+
+	n := &Nuke{
+		Parameters: NukeParameters{StateFile: filepath.Join(dir, "state.json")},
+		Config:     &config.Nuke{Regions: []string{"eu-west-1", "eu-central-1"}},
+		items: Queue{
+			newItem("eu-west-1", "TestResource", "removed", ItemStateFinished),
+			newItem("eu-west-1", "TestResource", "protected", ItemStateFiltered),
+			newItem("eu-west-1", "OtherResource", "waiting", ItemStateWaiting),
+			newItem("eu-central-1", "TestResource", "failed", ItemStateFailed),
+		},
+	}
+
+	err = n.SaveState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = n.LoadResumeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all := []string{"TestResource", "OtherResource"}
+	if have := n.resumeTypes("eu-west-1", all); !reflect.DeepEqual(have, []string{"OtherResource"}) {
+		t.Errorf("Wrong resource types for eu-west-1: %v", have)
+	}
+	if have := n.resumeTypes("eu-central-1", all); !reflect.DeepEqual(have, []string{"TestResource"}) {
+		t.Errorf("Wrong resource types for eu-central-1: %v", have)
+	}
+
+	n.items = Queue{
+		newItem("eu-west-1", "OtherResource", "waiting", ItemStateNew),
+		newItem("eu-central-1", "TestResource", "failed", ItemStateNew),
+	}
+	err = n.ResumeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]ItemState{
+		"waiting":   ItemStatePending,
+		"failed":    ItemStateNew,
+		"removed":   ItemStateFinished,
+		"protected": ItemStateFiltered,
+	}
+	if len(n.items) != len(want) {
+		t.Fatalf("Wrong number of items. Want: %d. Have: %d", len(want), len(n.items))
+	}
+	for _, item := range n.items {
+		name := item.Record().Properties["Name"]
+		if item.State != want[name] {
+			t.Errorf("Wrong state of %s. Want: %v. Have: %v", name, want[name], item.State)
+		}
+	}
+}
+
+func TestStateSeveralAccounts(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aws-nuke-state")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	params := NukeParameters{StateFile: filepath.Join(dir, "state.json")}
+	cfg := &config.Nuke{Regions: []string{"eu-west-1"}}
+	first := &Nuke{Parameters: params.ForAccount("111111111111"), Config: cfg}
+	second := &Nuke{Parameters: params.ForAccount("222222222222"), Config: cfg}
+
+	// The first account fails and leaves its state behind.
+	first.items = Queue{&Item{
+		Region:   &Region{Name: "eu-west-1"},
+		Type:     "TestResource",
+		State:    ItemStateFailed,
+		Resource: &testResource{},
+	}}
+	err = first.SaveState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The second account neither sees nor removes it.
+	err = second.LoadResumeState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.resumeState != nil {
+		t.Errorf("The second account resumes the state of the first one.")
+	}
+
+	err = second.RemoveState()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state, err := LoadState(first.Parameters.StateFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if state == nil || len(state.Items) != 1 {
+		t.Errorf("The state of the first account got lost: %#v", state)
+	}
+}
@@ -2,30 +2,66 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
-func Prompt(expect string) error {
-	fmt.Print("> ")
-	reader := bufio.NewReader(os.Stdin)
-	text, err := reader.ReadString('\n')
-	if err != nil {
-		return err
+// stdin is read by Prompt. It can be replaced in tests.
+var stdin io.Reader = os.Stdin
+
+// isInteractive returns true, if stdin is a terminal. It can be replaced in
+// tests.
+var isInteractive = func() bool {
+	info, err := os.Stdin.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Prompt reads a line from stdin and returns an error, if it is not the
+// expected text. The prompt is written to w. It returns ErrInterrupted, if the
+// context gets cancelled while waiting for the input.
+func Prompt(ctx context.Context, w io.Writer, expect string) error {
+	fmt.Fprint(w, "> ")
+
+	type line struct {
+		text string
+		err  error
+	}
+	reader := bufio.NewReader(stdin)
+	input := make(chan line, 1)
+	go func() {
+		text, err := reader.ReadString('\n')
+		input <- line{text: text, err: err}
+	}()
+
+	var text string
+	select {
+	case <-ctx.Done():
+		fmt.Fprintln(w)
+		return ErrInterrupted
+	case l := <-input:
+		if l.err != nil {
+			return l.err
+		}
+		text = l.text
 	}
 
 	if strings.TrimSpace(text) != expect {
 		return fmt.Errorf("aborted")
 	}
-	fmt.Println()
+	fmt.Fprintln(w)
 
 	return nil
 }
 
-func ResolveResourceTypes(base types.Collection, include, exclude []types.Collection) types.Collection {
+func ResolveResourceTypes(base types.Collection, include, exclude []types.Collection, groups map[string]types.Collection) types.Collection {
+	include = ExpandResourceTypes(base, groups, include)
+	exclude = ExpandResourceTypes(base, groups, exclude)
+
 	for _, i := range include {
 		if len(i) > 0 {
 			base = base.Intersect(i)
@@ -39,6 +75,37 @@ func ResolveResourceTypes(base types.Collection, include, exclude []types.Collec
 	return base
 }
 
+// ExpandResourceTypes replaces the groups and service names, like "ec2", in
+// the collections with their resource types.
+func ExpandResourceTypes(base types.Collection, groups map[string]types.Collection, collections []types.Collection) []types.Collection {
+	result := make([]types.Collection, len(collections))
+	for i, c := range collections {
+		result[i] = c.Expand(base, groups)
+	}
+	return result
+}
+
+// UnknownResourceTypes returns all resource types of the collections, which
+// are not part of the base. Each unknown type is only returned once.
+func UnknownResourceTypes(base types.Collection, collections ...types.Collection) []string {
+	known := map[string]bool{}
+	for _, name := range base {
+		known[name] = true
+	}
+
+	unknown := []string{}
+	for _, c := range collections {
+		for _, name := range c {
+			if !known[name] {
+				unknown = append(unknown, name)
+				known[name] = true
+			}
+		}
+	}
+
+	return unknown
+}
+
 func IsTrue(s string) bool {
 	return strings.TrimSpace(strings.ToLower(s)) == "true"
 }
@@ -13,6 +13,7 @@ func TestResolveResourceTypes(t *testing.T) {
 		base    types.Collection
 		include []types.Collection
 		exclude []types.Collection
+		groups  map[string]types.Collection
 		result  types.Collection
 	}{
 		{
@@ -41,11 +42,23 @@ func TestResolveResourceTypes(t *testing.T) {
 			exclude: []types.Collection{types.Collection{"a"}},
 			result:  types.Collection{"b", "c"},
 		},
+		{
+			base:    types.Collection{"EC2Instance", "EC2VPC", "S3Bucket"},
+			include: []types.Collection{types.Collection{"ec2"}},
+			result:  types.Collection{"EC2Instance", "EC2VPC"},
+		},
+		{
+			base:    types.Collection{"EC2Instance", "EC2VPC", "EC2Subnet", "S3Bucket"},
+			include: []types.Collection{types.Collection{"networking"}},
+			exclude: []types.Collection{types.Collection{"EC2Subnet"}},
+			groups:  map[string]types.Collection{"networking": {"EC2VPC", "EC2Subnet"}},
+			result:  types.Collection{"EC2VPC"},
+		},
 	}
 
 	for i, tc := range cases {
 		t.Run(fmt.Sprint(i), func(t *testing.T) {
-			r := ResolveResourceTypes(tc.base, tc.include, tc.exclude)
+			r := ResolveResourceTypes(tc.base, tc.include, tc.exclude, tc.groups)
 
 			sort.Strings(r)
 			sort.Strings(tc.result)
@@ -62,6 +75,26 @@ func TestResolveResourceTypes(t *testing.T) {
 	}
 }
 
+func TestUnknownResourceTypes(t *testing.T) {
+	base := types.Collection{"a", "b", "c"}
+
+	have := UnknownResourceTypes(base,
+		types.Collection{"a", "x"},
+		types.Collection{},
+		types.Collection{"y", "x", "c"},
+	)
+
+	want := []string{"x", "y"}
+	if fmt.Sprint(want) != fmt.Sprint(have) {
+		t.Fatalf("Wrong result. Want: %v. Have: %v", want, have)
+	}
+
+	have = UnknownResourceTypes(base, types.Collection{"a", "b"})
+	if len(have) != 0 {
+		t.Fatalf("Expected no unknown types. Have: %v", have)
+	}
+}
+
 func TestIsTrue(t *testing.T) {
 	falseStrings := []string{"", "false", "treu", "foo"}
 	for _, fs := range falseStrings {
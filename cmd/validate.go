@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/resources"
+	"github.com/spf13/cobra"
+)
+
+// NewValidateConfigCommand checks the config file without accessing AWS, so
+// it can be used in CI pipelines.
+func NewValidateConfigCommand(params *NukeParameters) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-config",
+		Short: "validates the config file without accessing AWS",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if params.ConfigPath == "" {
+				return fmt.Errorf("You have to specify the --config flag.\n")
+			}
+			cmd.SilenceUsage = true
+
+			cfg, err := config.Load(params.ConfigPath)
+			if err != nil {
+				return fmt.Errorf("Failed to load config file %s: %v", params.ConfigPath, err)
+			}
+
+			errs := cfg.Validate(resources.GetListerNames())
+			for _, err := range errs {
+				fmt.Fprintf(cmd.OutOrStderr(), "%s: %v\n", params.ConfigPath, err)
+			}
+			if len(errs) > 0 {
+				return fmt.Errorf("Found %d problems in the config file %s.", len(errs), params.ConfigPath)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "The config file %s is valid.\n", params.ConfigPath)
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -23,6 +23,9 @@ type NukeParameters struct {
 
 func main() {
 	if err := cmd.NewRootCommand().Execute(); err != nil {
+		if err == cmd.ErrInterrupted {
+			os.Exit(130)
+		}
 		os.Exit(-1)
 	}
 }
@@ -1,8 +1,12 @@
 package awsutil
 
 import (
+	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
@@ -43,7 +47,7 @@ func NewAccount(creds Credentials, endpoints config.CustomEndpoints) (*Account,
 
 	identityOutput, err := sts.New(defaultSession).GetCallerIdentity(nil)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed get caller identity")
+		return nil, errors.Wrap(err, "failed get caller identity"+creds.credentialsHint(err))
 	}
 
 	globalSession, err := account.NewSession(GlobalRegionID, "")
@@ -84,6 +88,26 @@ func (a *Account) Aliases() []string {
 	return a.aliases
 }
 
+// EnabledRegions returns the names of all regions, which are enabled for the
+// account.
+func (a *Account) EnabledRegions() ([]string, error) {
+	sess, err := a.NewSession(DefaultRegionID, "ec2")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create session in %s", DefaultRegionID)
+	}
+
+	resp, err := ec2.New(sess).DescribeRegions(&ec2.DescribeRegionsInput{})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe regions")
+	}
+
+	regions := []string{}
+	for _, region := range resp.Regions {
+		regions = append(regions, *region.RegionName)
+	}
+	return regions, nil
+}
+
 func (a *Account) ResourceTypeToServiceType(regionName, resourceType string) string {
 	customRegion := a.CustomEndpoints.GetRegion(regionName)
 	if customRegion == nil {
@@ -96,3 +120,17 @@ func (a *Account) ResourceTypeToServiceType(regionName, resourceType string) str
 	}
 	return ""
 }
+
+// credentialsHint returns advice for errors of credential providers, which
+// the user can resolve outside of aws-nuke.
+func (c *Credentials) credentialsHint(err error) string {
+	aerr, ok := err.(awserr.Error)
+	if !ok || aerr.Code() != ssocreds.ErrCodeSSOProviderInvalidToken {
+		return ""
+	}
+
+	if c.HasProfile() {
+		return fmt.Sprintf(" (run 'aws sso login --profile %s' to refresh the SSO session)", strings.TrimSpace(c.Profile))
+	}
+	return " (run 'aws sso login' to refresh the SSO session)"
+}
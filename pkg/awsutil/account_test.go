@@ -0,0 +1,40 @@
+package awsutil
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+)
+
+func TestCredentialsHint(t *testing.T) {
+	ssoErr := awserr.New(ssocreds.ErrCodeSSOProviderInvalidToken, "the SSO session has expired or is invalid", nil)
+
+	cases := []struct {
+		name    string
+		creds   Credentials
+		err     error
+		contain string
+	}{
+		{name: "sso_profile", creds: Credentials{Profile: "dev"}, err: ssoErr, contain: "aws sso login --profile dev"},
+		{name: "sso_default", creds: Credentials{}, err: ssoErr, contain: "aws sso login"},
+		{name: "other_aws_error", creds: Credentials{Profile: "dev"}, err: awserr.New("AccessDenied", "denied", nil)},
+		{name: "other_error", creds: Credentials{Profile: "dev"}, err: fmt.Errorf("boom")},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			hint := tc.creds.credentialsHint(tc.err)
+
+			if tc.contain == "" && hint != "" {
+				t.Errorf("Expected no hint, but got '%s'.", hint)
+			}
+
+			if !strings.Contains(hint, tc.contain) {
+				t.Errorf("Expected hint to contain '%s', but got '%s'.", tc.contain, hint)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package awsutil
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+)
+
+// RateLimiter throttles the requests to the AWS API on the client side. It
+// has a global limit and optional limits per service, which take precedence
+// over the global one. A limit of zero means unlimited.
+type RateLimiter struct {
+	global   *limiter
+	services map[string]*limiter
+}
+
+func NewRateLimiter(maxRPS float64, serviceRPS map[string]float64) *RateLimiter {
+	l := &RateLimiter{
+		global:   newLimiter(maxRPS),
+		services: map[string]*limiter{},
+	}
+
+	for service, rps := range serviceRPS {
+		l.services[service] = newLimiter(rps)
+	}
+
+	return l
+}
+
+// Wait blocks until a request to the given service is allowed.
+func (l *RateLimiter) Wait(service string) {
+	if l == nil {
+		return
+	}
+
+	sl, ok := l.services[service]
+	if ok {
+		sl.wait()
+		return
+	}
+
+	l.global.wait()
+}
+
+func (l *RateLimiter) handler(r *request.Request) {
+	l.Wait(r.ClientInfo.ServiceName)
+}
+
+// limiter is a token bucket with a size of one, which gets refilled with the
+// given rate.
+type limiter struct {
+	lock     sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+func newLimiter(rps float64) *limiter {
+	if rps <= 0 {
+		return nil
+	}
+
+	return &limiter{
+		interval: time.Duration(float64(time.Second) / rps),
+	}
+}
+
+func (l *limiter) wait() {
+	if l == nil {
+		return
+	}
+
+	l.lock.Lock()
+	now := time.Now()
+	if l.next.Before(now) {
+		l.next = now
+	}
+	delay := l.next.Sub(now)
+	l.next = l.next.Add(l.interval)
+	l.lock.Unlock()
+
+	time.Sleep(delay)
+}
@@ -0,0 +1,51 @@
+package awsutil_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/awsutil"
+)
+
+func TestRateLimiter(t *testing.T) {
+	l := awsutil.NewRateLimiter(100, map[string]float64{"iam": 50, "ec2": 0})
+
+	cases := []struct {
+		service string
+		min     time.Duration
+	}{
+		{service: "s3", min: 100 * time.Millisecond},
+		{service: "iam", min: 200 * time.Millisecond},
+		{service: "ec2", min: 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.service, func(t *testing.T) {
+			start := time.Now()
+			for i := 0; i < 11; i++ {
+				l.Wait(tc.service)
+			}
+			elapsed := time.Since(start)
+
+			if elapsed < tc.min {
+				t.Errorf("Requests were not throttled. Want at least %v. Got %v.", tc.min, elapsed)
+			}
+			if tc.min == 0 && elapsed > 50*time.Millisecond {
+				t.Errorf("Requests were throttled unexpectedly. Took %v.", elapsed)
+			}
+		})
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	var l *awsutil.RateLimiter
+
+	start := time.Now()
+	for i := 0; i < 100; i++ {
+		l.Wait("s3")
+	}
+
+	if time.Since(start) > 50*time.Millisecond {
+		t.Errorf("A nil rate limiter must not throttle requests.")
+	}
+}
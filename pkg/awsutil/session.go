@@ -6,8 +6,10 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -33,10 +35,33 @@ type Credentials struct {
 	SecretAccessKey string
 	SessionToken    string
 
+	// AssumeRoleArn is the role, which gets assumed with the credentials
+	// above, before accessing the account.
+	AssumeRoleArn   string
+	ExternalID      string
+	RoleSessionName string
+
 	Credentials *credentials.Credentials
 
 	CustomEndpoints config.CustomEndpoints
-	session         *session.Session
+	RateLimiter     *RateLimiter
+
+	// EndpointURL overrides the endpoint of all services (eg for LocalStack).
+	// TLSInsecureSkipVerify disables the verification of its certificate.
+	EndpointURL           string
+	TLSInsecureSkipVerify bool
+
+	session *session.Session
+
+	// MaxRetries and RetryBaseDelay configure the retries of requests, which
+	// failed because of throttling, timeouts or server errors. A zero
+	// RetryBaseDelay uses the defaults of the AWS SDK.
+	MaxRetries     int
+	RetryBaseDelay time.Duration
+
+	// OnRequestError gets called with the service name for every failed
+	// request to the AWS API, if set.
+	OnRequestError func(service string)
 }
 
 func (c *Credentials) HasProfile() bool {
@@ -47,6 +72,14 @@ func (c *Credentials) HasAwsCredentials() bool {
 	return c.Credentials != nil
 }
 
+func (c *Credentials) HasAssumeRole() bool {
+	return strings.TrimSpace(c.AssumeRoleArn) != ""
+}
+
+func (c *Credentials) HasEndpointURL() bool {
+	return strings.TrimSpace(c.EndpointURL) != ""
+}
+
 func (c *Credentials) HasKeys() bool {
 	return strings.TrimSpace(c.AccessKeyID) != "" ||
 		strings.TrimSpace(c.SecretAccessKey) != "" ||
@@ -60,6 +93,19 @@ func (c *Credentials) Validate() error {
 			"--session-token.\n")
 	}
 
+	if !c.HasAssumeRole() && (c.ExternalID != "" || c.RoleSessionName != "") {
+		return fmt.Errorf("The flags --external-id and --role-session-name " +
+			"require --assume-role-arn.\n")
+	}
+
+	if !c.HasEndpointURL() && c.TLSInsecureSkipVerify {
+		return fmt.Errorf("The flag --tls-insecure-skip-verify requires --endpoint-url.\n")
+	}
+
+	if c.MaxRetries < 0 {
+		return fmt.Errorf("The number of retries must not be negative.\n")
+	}
+
 	return nil
 }
 
@@ -102,17 +148,42 @@ func (c *Credentials) rootSession() (*session.Session, error) {
 		opts.Config.Region = aws.String(region)
 		opts.Config.DisableRestProtocolURICleaning = aws.Bool(true)
 
+		if c.HasEndpointURL() {
+			log.Debugf("using custom endpoint %s", c.EndpointURL)
+			opts.Config.Endpoint = aws.String(strings.TrimSpace(c.EndpointURL))
+			opts.Config.S3ForcePathStyle = aws.Bool(true)
+			if c.TLSInsecureSkipVerify {
+				opts.Config.HTTPClient = insecureHTTPClient()
+			}
+		}
+
 		sess, err := session.NewSessionWithOptions(opts)
 		if err != nil {
 			return nil, err
 		}
 
+		if c.HasAssumeRole() {
+			log.Debugf("assuming role %s", c.AssumeRoleArn)
+			sess = sess.Copy(&aws.Config{
+				Credentials: stscreds.NewCredentials(sess, strings.TrimSpace(c.AssumeRoleArn), c.assumeRoleOptions),
+			})
+		}
+
 		c.session = sess
 	}
 
 	return c.session, nil
 }
 
+func (c *Credentials) assumeRoleOptions(p *stscreds.AssumeRoleProvider) {
+	if c.ExternalID != "" {
+		p.ExternalID = aws.String(c.ExternalID)
+	}
+	if c.RoleSessionName != "" {
+		p.RoleSessionName = c.RoleSessionName
+	}
+}
+
 func (c *Credentials) awsNewStaticCredentials() *credentials.Credentials {
 	if !c.HasKeys() {
 		return credentials.NewEnvCredentials()
@@ -149,9 +220,7 @@ func (c *Credentials) NewSession(region, serviceType string) (*session.Session,
 			Credentials: c.awsNewStaticCredentials(),
 		}
 		if customService.TLSInsecureSkipVerify {
-			conf.HTTPClient = &http.Client{Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
-			}}
+			conf.HTTPClient = insecureHTTPClient()
 		}
 		// ll := aws.LogDebugWithEventStreamBody
 		// conf.LogLevel = &ll
@@ -178,6 +247,22 @@ func (c *Credentials) NewSession(region, serviceType string) (*session.Session,
 		log.Debugf("sending AWS request:\n%s", DumpRequest(r.HTTPRequest))
 	})
 
+	sess.Config.Retryer = c.retryer()
+
+	if c.OnRequestError != nil {
+		sess.Handlers.CompleteAttempt.PushBack(func(r *request.Request) {
+			switch r.Error.(type) {
+			case nil, ErrSkipRequest, ErrUnknownEndpoint:
+				return
+			}
+			c.OnRequestError(r.ClientInfo.ServiceName)
+		})
+	}
+
+	if c.RateLimiter != nil {
+		sess.Handlers.Send.PushFront(c.RateLimiter.handler)
+	}
+
 	sess.Handlers.ValidateResponse.PushFront(func(r *request.Request) {
 		log.Debugf("received AWS response:\n%s", DumpResponse(r.HTTPResponse))
 	})
@@ -189,6 +274,22 @@ func (c *Credentials) NewSession(region, serviceType string) (*session.Session,
 	return sess, nil
 }
 
+func insecureHTTPClient() *http.Client {
+	return &http.Client{Transport: &http.Transport{
+		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+	}}
+}
+
+// retryer returns an exponential backoff with jitter, which only retries
+// transient errors. Other errors (eg AccessDenied) are returned immediately.
+func (c *Credentials) retryer() request.Retryer {
+	return client.DefaultRetryer{
+		NumMaxRetries:    c.MaxRetries,
+		MinRetryDelay:    c.RetryBaseDelay,
+		MinThrottleDelay: c.RetryBaseDelay,
+	}
+}
+
 func skipMissingServiceInRegionHandler(r *request.Request) {
 	region := *r.Config.Region
 	service := r.ClientInfo.ServiceName
@@ -3,39 +3,82 @@ package config
 import (
 	"fmt"
 	"io/ioutil"
+	"path"
+	"time"
 
+	"github.com/mb0/glob"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 
 	log "github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
 
+// MinPollInterval is the lower bound for the time between two passes over the
+// removal queue. It prevents users from hammering the AWS API by accident.
+const MinPollInterval = time.Second
+
 type ResourceTypes struct {
 	Targets  types.Collection `yaml:"targets"`
 	Excludes types.Collection `yaml:"excludes"`
 }
 
 type Account struct {
-	Filters       Filters       `yaml:"filters"`
-	ResourceTypes ResourceTypes `yaml:"resource-types"`
-	Presets       []string      `yaml:"presets"`
+	Filters       Filters              `yaml:"filters"`
+	ResourceTypes ResourceTypes        `yaml:"resource-types"`
+	Presets       []string             `yaml:"presets"`
+	FeatureFlags  FeatureFlagOverrides `yaml:"feature-flags"`
 }
 
+// FeatureFlagOverrides contains the feature flags of an account in the same
+// format as the global ones. Flags, which are not set, keep their global
+// value.
+type FeatureFlagOverrides map[string]interface{}
+
 type Nuke struct {
 	// Deprecated: Use AccountBlocklist instead.
 	AccountBlacklist []string                     `yaml:"account-blacklist"`
 	AccountBlocklist []string                     `yaml:"account-blocklist"`
+	AccountAllowlist []string                     `yaml:"account-allowlist"`
 	Regions          []string                     `yaml:"regions"`
 	Accounts         map[string]Account           `yaml:"accounts"`
 	ResourceTypes    ResourceTypes                `yaml:"resource-types"`
 	Presets          map[string]PresetDefinitions `yaml:"presets"`
 	FeatureFlags     FeatureFlags                 `yaml:"feature-flags"`
 	CustomEndpoints  CustomEndpoints              `yaml:"endpoints"`
+	PollInterval     time.Duration                `yaml:"poll-interval"`
+	RateLimits       map[string]float64           `yaml:"rate-limits"`
+	GlobalTagFilters []TagFilter                  `yaml:"global-tag-filters"`
+
+	// ProtectedAccounts are never nuked, even if they are reachable by an
+	// assumed role. Unlike the blocklist it is meant for the accounts, which
+	// run aws-nuke, like the management account of an organization.
+	ProtectedAccounts []string `yaml:"protected-accounts"`
+
+	// DeleteTimeouts overrides the --delete-timeout of resource types. A
+	// timeout of 0 disables it for the resource type.
+	DeleteTimeouts map[string]time.Duration `yaml:"delete-timeouts"`
+
+	// ResourceTypeGroups are named lists of resource types, which can be used
+	// in the targets and excludes instead of the single resource types.
+	ResourceTypeGroups map[string]types.Collection `yaml:"resource-type-groups"`
+
+	// DeletionPriorities overrides the default priorities of resource types.
+	// Resource types with a higher priority get removed first.
+	DeletionPriorities map[string]int `yaml:"deletion-priorities"`
 }
 
 type FeatureFlags struct {
-	DisableDeletionProtection  DisableDeletionProtection `yaml:"disable-deletion-protection"`
-	ForceDeleteLightsailAddOns bool                      `yaml:"force-delete-lightsail-addons"`
+	DisableDeletionProtection   DisableDeletionProtection `yaml:"disable-deletion-protection"`
+	ForceDeleteLightsailAddOns  bool                      `yaml:"force-delete-lightsail-addons"`
+	SkipFSxFinalBackup          bool                      `yaml:"skip-fsx-final-backup"`
+	ForceDeleteSecrets          bool                      `yaml:"force-delete-secrets"`
+	DisableOrganizationMacie    bool                      `yaml:"disable-organization-macie"`
+	KeepNonEmptyECRRepositories bool                      `yaml:"keep-non-empty-ecr-repositories"`
+	DeleteDefaultResources      bool                      `yaml:"delete-default-resources"`
+	BypassS3GovernanceRetention bool                      `yaml:"bypass-s3-governance-retention"`
+	S3DeleteConcurrency         int                       `yaml:"s3-delete-concurrency"`
+	KMSPendingWindowInDays      int                       `yaml:"kms-pending-window-in-days"`
+	NoRetainFailedCFNResources  bool                      `yaml:"no-retain-failed-cloudformation-resources"`
 }
 
 type DisableDeletionProtection struct {
@@ -82,6 +125,34 @@ func Load(path string) (*Nuke, error) {
 		return nil, err
 	}
 
+	// A malformed pattern would never match, so the account lists are
+	// rejected instead of silently failing open.
+	if err := validateAccountPatterns("account-blocklist", config.AccountBlocklist); err != nil {
+		return nil, err
+	}
+	if err := validateAccountPatterns("account-blacklist", config.AccountBlacklist); err != nil {
+		return nil, err
+	}
+	if err := validateAccountPatterns("account-allowlist", config.AccountAllowlist); err != nil {
+		return nil, err
+	}
+
+	if config.PollInterval != 0 && config.PollInterval < MinPollInterval {
+		return nil, fmt.Errorf("poll-interval must be at least %v", MinPollInterval)
+	}
+
+	for accountID := range config.Accounts {
+		_, err := config.AccountFeatureFlags(accountID)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = config.Filters(accountID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid presets of account %s: %v", accountID, err)
+		}
+	}
+
 	return config, nil
 }
 
@@ -99,9 +170,71 @@ func (c *Nuke) HasBlocklist() bool {
 	return blocklist != nil && len(blocklist) > 0
 }
 
-func (c *Nuke) InBlocklist(searchID string) bool {
-	for _, blocklistID := range c.ResolveBlocklist() {
-		if blocklistID == searchID {
+// InBlocklist returns true, if the account ID or one of the aliases matches an
+// entry of the blocklist.
+// Invalid patterns are treated as a match, so they cannot weaken the
+// blocklist.
+func (c *Nuke) InBlocklist(searchID string, aliases ...string) bool {
+	match, err := matchAccount(c.ResolveBlocklist(), searchID, aliases)
+	return match || err != nil
+}
+
+// InAllowlist returns true, if the allowlist is empty or if the account ID or
+// one of the aliases matches an entry of it. Invalid patterns refuse all
+// accounts.
+func (c *Nuke) InAllowlist(searchID string, aliases ...string) bool {
+	if len(c.AccountAllowlist) == 0 {
+		return true
+	}
+	match, err := matchAccount(c.AccountAllowlist, searchID, aliases)
+	return match && err == nil
+}
+
+// matchAccount returns true, if one of the patterns matches the account ID or
+// one of the aliases. Patterns may contain glob wildcards, like "sandbox-*".
+// It returns an error for the first invalid pattern.
+func matchAccount(patterns []string, accountID string, aliases []string) (bool, error) {
+	names := append([]string{accountID}, aliases...)
+	matched := false
+	for _, pattern := range patterns {
+		if err := validatePattern(pattern); err != nil {
+			return false, fmt.Errorf("invalid account pattern '%s': %v", pattern, err)
+		}
+		for _, name := range names {
+			match, err := glob.Match(pattern, name)
+			if err != nil {
+				return false, fmt.Errorf("invalid account pattern '%s': %v", pattern, err)
+			}
+			matched = matched || match
+		}
+	}
+
+	return matched, nil
+}
+
+// validateAccountPatterns returns an error for the first invalid pattern of
+// the account list with the given key.
+func validateAccountPatterns(key string, patterns []string) error {
+	for _, pattern := range patterns {
+		if err := validatePattern(pattern); err != nil {
+			return fmt.Errorf("invalid pattern '%s' in %s: %v", pattern, key, err)
+		}
+	}
+	return nil
+}
+
+// validatePattern checks the syntax of the whole pattern. glob.Match only
+// reports syntax errors of the part it actually reached, while path.Match
+// uses the same syntax and checks the rest of the pattern on a mismatch.
+func validatePattern(pattern string) error {
+	_, err := path.Match(pattern, "")
+	return err
+}
+
+// IsProtected returns true, if the account is in the protected accounts.
+func (c *Nuke) IsProtected(accountID string) bool {
+	for _, id := range c.ProtectedAccounts {
+		if id == accountID {
 			return true
 		}
 	}
@@ -110,20 +243,32 @@ func (c *Nuke) InBlocklist(searchID string) bool {
 }
 
 func (c *Nuke) ValidateAccount(accountID string, aliases []string) error {
+	if c.IsProtected(accountID) {
+		return fmt.Errorf("REFUSING TO NUKE THE PROTECTED ACCOUNT %s! "+
+			"It is listed in the protected accounts and must never be nuked. Aborting.", accountID)
+	}
+
 	if !c.HasBlocklist() {
 		return fmt.Errorf("The config file contains an empty blocklist. " +
 			"For safety reasons you need to specify at least one account ID. " +
 			"This should be your production account.")
 	}
 
-	if c.InBlocklist(accountID) {
+	if c.InBlocklist(accountID, aliases...) {
 		return fmt.Errorf("You are trying to nuke the account with the ID %s, "+
 			"but it is blocklisted. Aborting.", accountID)
 	}
 
+	if !c.InAllowlist(accountID, aliases...) {
+		return fmt.Errorf("You are trying to nuke the account with the ID %s, "+
+			"but it is not in the allowlist. Aborting.", accountID)
+	}
+
 	return nil
 }
 
+// Filters returns the filters of the account including the filters of its
+// presets. The returned filters must not be modified.
 func (c *Nuke) Filters(accountID string) (Filters, error) {
 	var filters Filters
 	var presets []string
@@ -143,6 +288,11 @@ func (c *Nuke) Filters(accountID string) (Filters, error) {
 		return filters, nil
 	}
 
+	// Merge into a copy, so the filters of the account stay unchanged.
+	merged := Filters{}
+	merged.Merge(filters)
+	filters = merged
+
 	for _, presetName := range presets {
 		notFound := fmt.Errorf("Could not find filter preset '%s'", presetName)
 		if c.Presets == nil {
@@ -160,6 +310,36 @@ func (c *Nuke) Filters(accountID string) (Filters, error) {
 	return filters, nil
 }
 
+// AccountFeatureFlags returns the global feature flags with the overrides of
+// the account applied.
+func (c *Nuke) AccountFeatureFlags(accountID string) (FeatureFlags, error) {
+	var overrides FeatureFlagOverrides
+	if account, ok := c.Accounts[accountID]; ok {
+		overrides = account.FeatureFlags
+	} else if defaults, ok := c.Accounts["__default__"]; ok {
+		overrides = defaults.FeatureFlags
+	}
+
+	flags := c.FeatureFlags
+	if len(overrides) == 0 {
+		return flags, nil
+	}
+
+	raw, err := yaml.Marshal(overrides)
+	if err != nil {
+		return flags, err
+	}
+
+	// Decoding into the global flags only replaces the flags, which are set
+	// for the account.
+	err = yaml.UnmarshalStrict(raw, &flags)
+	if err != nil {
+		return flags, fmt.Errorf("invalid feature flags of account %s: %v", accountID, err)
+	}
+
+	return flags, nil
+}
+
 func (c *Nuke) resolveDeprecations() error {
 	deprecations := map[string]string{
 		"EC2DhcpOptions":                "EC2DHCPOptions",
@@ -2,11 +2,14 @@ package config
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
 	"reflect"
 	"strings"
 	"testing"
 
 	"github.com/rebuy-de/aws-nuke/pkg/types"
+	"gopkg.in/yaml.v2"
 )
 
 func TestConfigBlocklist(t *testing.T) {
@@ -45,6 +48,95 @@ func TestConfigBlocklist(t *testing.T) {
 	}
 }
 
+func TestConfigAccountPatterns(t *testing.T) {
+	config := &Nuke{
+		AccountBlocklist: []string{"111111111111", "prod-*"},
+		AccountAllowlist: []string{"sandbox-*", "2222222222??"},
+	}
+
+	cases := []struct {
+		id      string
+		aliases []string
+		valid   bool
+	}{
+		{id: "333333333333", aliases: []string{"sandbox-foo"}, valid: true},
+		{id: "222222222233", valid: true},
+		{id: "111111111111", aliases: []string{"sandbox-foo"}, valid: false},
+		{id: "222222222233", aliases: []string{"prod-foo"}, valid: false},
+		{id: "333333333333", aliases: []string{"dev-foo"}, valid: false},
+		{id: "333333333333", valid: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(fmt.Sprintf("%s%v", tc.id, tc.aliases), func(t *testing.T) {
+			err := config.ValidateAccount(tc.id, tc.aliases)
+			if tc.valid && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if !tc.valid && err == nil {
+				t.Errorf("Expected the account to be refused.")
+			}
+		})
+	}
+}
+
+func TestConfigInvalidAccountPatterns(t *testing.T) {
+	config := &Nuke{
+		AccountBlocklist: []string{"prod-["},
+		AccountAllowlist: []string{"sandbox-["},
+	}
+
+	if !config.InBlocklist("333333333333", "dev-foo") {
+		t.Errorf("Invalid blocklist pattern did not block the account.")
+	}
+	if config.InAllowlist("333333333333", "sandbox-foo") {
+		t.Errorf("Invalid allowlist pattern allowed the account.")
+	}
+}
+
+func TestLoadInvalidAccountPattern(t *testing.T) {
+	file, err := ioutil.TempFile("", "aws-nuke-config-*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(`
+account-blocklist:
+- "prod-["
+`)
+	file.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Load(file.Name())
+	if err == nil || !strings.Contains(err.Error(), "account-blocklist") {
+		t.Fatalf("Expected an error about the account-blocklist. Got: %v", err)
+	}
+}
+
+func TestConfigProtectedAccounts(t *testing.T) {
+	config := &Nuke{
+		AccountBlocklist:  []string{"111111111111"},
+		ProtectedAccounts: []string{"222222222222"},
+	}
+
+	if !config.IsProtected("222222222222") {
+		t.Errorf("IsProtected() returned false for a protected account.")
+	}
+
+	err := config.ValidateAccount("222222222222", nil)
+	if err == nil || !strings.Contains(err.Error(), "PROTECTED ACCOUNT 222222222222") {
+		t.Errorf("ValidateAccount() did not refuse the protected account: %v", err)
+	}
+
+	err = config.ValidateAccount("333333333333", nil)
+	if err != nil {
+		t.Errorf("ValidateAccount() refused an unprotected account: %v", err)
+	}
+}
+
 func TestLoadExampleConfig(t *testing.T) {
 	config, err := Load("test-fixtures/example.yaml")
 	if err != nil {
@@ -281,6 +373,59 @@ func TestFilterMerge(t *testing.T) {
 	}
 }
 
+func TestFilterMergeRepeated(t *testing.T) {
+	config, err := Load("test-fixtures/example.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := config.Filters("555133742")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := config.Filters("555133742")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(first, second) {
+		t.Errorf("Filters changed between calls:")
+		t.Errorf("  First:  %#v", first)
+		t.Errorf("  Second: %#v", second)
+	}
+
+	if len(config.Accounts["555133742"].Filters["S3Bucket"]) != 0 {
+		t.Errorf("Preset filters got merged into the account filters.")
+	}
+}
+
+func TestLoadMissingPreset(t *testing.T) {
+	file, err := ioutil.TempFile("", "aws-nuke-config-*.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(`
+account-blocklist:
+- "1234567890"
+accounts:
+  "555133742":
+    presets:
+    - missing
+`)
+	file.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = Load(file.Name())
+	if err == nil {
+		t.Fatal("Expected an error but didn't get one.")
+	}
+}
+
 func TestGetCustomRegion(t *testing.T) {
 	config, err := Load("test-fixtures/example.yaml")
 	if err != nil {
@@ -307,3 +452,75 @@ func TestGetCustomRegion(t *testing.T) {
 
 	})
 }
+
+func TestAccountFeatureFlags(t *testing.T) {
+	raw := `
+feature-flags:
+  force-delete-secrets: true
+  disable-deletion-protection:
+    RDSInstance: true
+accounts:
+  "1111":
+    feature-flags:
+      force-delete-secrets: false
+      disable-deletion-protection:
+        EC2Instance: true
+  "2222": {}
+`
+	var config Nuke
+	err := yaml.UnmarshalStrict([]byte(raw), &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		accountID string
+		want      FeatureFlags
+	}{
+		{
+			accountID: "1111",
+			want: FeatureFlags{
+				ForceDeleteSecrets: false,
+				DisableDeletionProtection: DisableDeletionProtection{
+					RDSInstance: true,
+					EC2Instance: true,
+				},
+			},
+		},
+		{
+			accountID: "2222",
+			want: FeatureFlags{
+				ForceDeleteSecrets: true,
+				DisableDeletionProtection: DisableDeletionProtection{
+					RDSInstance: true,
+				},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.accountID, func(t *testing.T) {
+			have, err := config.AccountFeatureFlags(tc.accountID)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(tc.want, have) {
+				t.Errorf("Wrong feature flags. Want: %#v. Have: %#v", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestAccountFeatureFlagsInvalid(t *testing.T) {
+	config := Nuke{
+		Accounts: map[string]Account{
+			"1111": {FeatureFlags: FeatureFlagOverrides{"no-such-flag": true}},
+		},
+	}
+
+	_, err := config.AccountFeatureFlags("1111")
+	if err == nil {
+		t.Fatal("Expected an error but didn't get one.")
+	}
+}
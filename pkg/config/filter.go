@@ -18,7 +18,26 @@ const (
 	FilterTypeGlob                     = "glob"
 	FilterTypeRegex                    = "regex"
 	FilterTypeContains                 = "contains"
+	FilterTypePrefix                   = "prefix"
+	FilterTypeSuffix                   = "suffix"
 	FilterTypeDateOlderThan            = "dateOlderThan"
+
+	FilterTypeNumericGreaterThan = "numericGreaterThan"
+	FilterTypeNumericLessThan    = "numericLessThan"
+	FilterTypeNumericEquals      = "numericEquals"
+)
+
+// Operators to combine the conditions of a filter.
+const (
+	FilterOperatorAnd = "and"
+	FilterOperatorOr  = "or"
+)
+
+// Possible ways to handle missing or unparseable timestamps in date filters.
+const (
+	InvalidDateDefault  = ""
+	InvalidDateMatch    = "match"
+	InvalidDateMismatch = "mismatch"
 )
 
 type Filters map[string][]Filter
@@ -34,6 +53,25 @@ type Filter struct {
 	Type     FilterType
 	Value    string
 	Invert   string
+
+	// CaseInsensitive makes exact, contains, prefix and suffix filters
+	// ignore the case.
+	CaseInsensitive string
+
+	// OnInvalidDate defines whether date filters match resources with a
+	// missing or unparseable timestamp. By default a missing timestamp does
+	// not match and an unparseable one results in an error.
+	OnInvalidDate string
+
+	// Conditions are sub-filters, which get combined with the Operator
+	// instead of matching Property against Value. Each condition references
+	// its own property.
+	Conditions []Filter
+	Operator   string
+
+	// regex is the compiled Value of regex filters. It gets compiled while
+	// loading the config, so invalid patterns are detected early.
+	regex *regexp.Regexp
 }
 
 func (f Filter) Match(o string) (bool, error) {
@@ -42,42 +80,108 @@ func (f Filter) Match(o string) (bool, error) {
 		fallthrough
 
 	case FilterTypeExact:
-		return f.Value == o, nil
+		value, o := f.normalizeCase(f.Value, o)
+		return value == o, nil
 
 	case FilterTypeContains:
-		return strings.Contains(o, f.Value), nil
+		value, o := f.normalizeCase(f.Value, o)
+		return strings.Contains(o, value), nil
+
+	case FilterTypePrefix:
+		value, o := f.normalizeCase(f.Value, o)
+		return strings.HasPrefix(o, value), nil
+
+	case FilterTypeSuffix:
+		value, o := f.normalizeCase(f.Value, o)
+		return strings.HasSuffix(o, value), nil
 
 	case FilterTypeGlob:
 		return glob.Match(f.Value, o)
 
 	case FilterTypeRegex:
-		re, err := regexp.Compile(f.Value)
-		if err != nil {
-			return false, err
+		re := f.regex
+		if re == nil {
+			var err error
+			re, err = regexp.Compile(f.Value)
+			if err != nil {
+				return false, err
+			}
 		}
 		return re.MatchString(o), nil
 
 	case FilterTypeDateOlderThan:
-		if o == "" {
-			return false, nil
-		}
 		duration, err := time.ParseDuration(f.Value)
 		if err != nil {
 			return false, err
 		}
 		fieldTime, err := parseDate(o)
 		if err != nil {
-			return false, err
+			return f.matchInvalidDate(o, err)
 		}
 		fieldTimeWithOffset := fieldTime.Add(duration)
 
 		return fieldTimeWithOffset.After(time.Now()), nil
 
+	case FilterTypeNumericGreaterThan, FilterTypeNumericLessThan, FilterTypeNumericEquals:
+		return f.matchNumeric(o)
+
 	default:
 		return false, fmt.Errorf("unknown type %s", f.Type)
 	}
 }
 
+// normalizeCase converts both strings to lower case, if the filter is case
+// insensitive.
+func (f Filter) normalizeCase(a, b string) (string, string) {
+	if strings.TrimSpace(strings.ToLower(f.CaseInsensitive)) != "true" {
+		return a, b
+	}
+	return strings.ToLower(a), strings.ToLower(b)
+}
+
+// matchNumeric compares the property and the filter value as numbers. An
+// empty property does not match, since the resource does not provide a value.
+func (f Filter) matchNumeric(o string) (bool, error) {
+	value, err := strconv.ParseFloat(f.Value, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s filter value '%s': not a number", f.Type, f.Value)
+	}
+
+	if o == "" {
+		return false, nil
+	}
+
+	number, err := strconv.ParseFloat(strings.TrimSpace(o), 64)
+	if err != nil {
+		return false, fmt.Errorf("unable to compare non-numeric value '%s' with %s filter", o, f.Type)
+	}
+
+	switch f.Type {
+	case FilterTypeNumericGreaterThan:
+		return number > value, nil
+	case FilterTypeNumericLessThan:
+		return number < value, nil
+	default:
+		return number == value, nil
+	}
+}
+
+func (f Filter) matchInvalidDate(o string, err error) (bool, error) {
+	switch f.OnInvalidDate {
+	case InvalidDateMatch:
+		return true, nil
+	case InvalidDateMismatch:
+		return false, nil
+	case InvalidDateDefault:
+		if o == "" {
+			return false, nil
+		}
+		return false, err
+	default:
+		return false, fmt.Errorf("unknown on-invalid-date value %s", f.OnInvalidDate)
+	}
+}
+
 func parseDate(input string) (time.Time, error) {
 	if i, err := strconv.ParseInt(input, 10, 64); err == nil {
 		t := time.Unix(i, 0)
@@ -108,19 +212,76 @@ func (f *Filter) UnmarshalYAML(unmarshal func(interface{}) error) error {
 		return nil
 	}
 
-	m := map[string]string{}
-	err := unmarshal(m)
+	var m struct {
+		Type            string   `yaml:"type"`
+		Value           string   `yaml:"value"`
+		Property        string   `yaml:"property"`
+		Invert          string   `yaml:"invert"`
+		CaseInsensitive string   `yaml:"case-insensitive"`
+		OnInvalidDate   string   `yaml:"on-invalid-date"`
+		Conditions      []Filter `yaml:"conditions"`
+		Operator        string   `yaml:"operator"`
+	}
+	err := unmarshal(&m)
 	if err != nil {
 		return err
 	}
 
-	f.Type = FilterType(m["type"])
-	f.Value = m["value"]
-	f.Property = m["property"]
-	f.Invert = m["invert"]
+	f.Type = FilterType(m.Type)
+	f.Value = m.Value
+	f.Property = m.Property
+	f.Invert = m.Invert
+	f.CaseInsensitive = m.CaseInsensitive
+	f.OnInvalidDate = m.OnInvalidDate
+	f.Conditions = m.Conditions
+	f.Operator = m.Operator
+
+	if len(f.Conditions) > 0 {
+		switch f.Operator {
+		case FilterOperatorAnd, FilterOperatorOr:
+		default:
+			return fmt.Errorf("invalid filter operator '%s': must be '%s' or '%s'",
+				f.Operator, FilterOperatorAnd, FilterOperatorOr)
+		}
+	} else if f.Operator != "" {
+		return fmt.Errorf("filter operator '%s' requires conditions", f.Operator)
+	}
+
+	if f.Type == FilterTypeRegex {
+		f.regex, err = regexp.Compile(f.Value)
+		if err != nil {
+			return fmt.Errorf("invalid regex filter '%s': %v", f.Value, err)
+		}
+	}
+
+	switch f.Type {
+	case FilterTypeNumericGreaterThan, FilterTypeNumericLessThan, FilterTypeNumericEquals:
+		_, err = strconv.ParseFloat(f.Value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid %s filter '%s': not a number", f.Type, f.Value)
+		}
+	}
+
 	return nil
 }
 
+// TagFilter protects every resource with a matching tag, regardless of its
+// resource type.
+type TagFilter struct {
+	Key   string     `yaml:"key"`
+	Type  FilterType `yaml:"type"`
+	Value string     `yaml:"value"`
+}
+
+// Filter returns the equivalent property filter of the tag filter.
+func (t TagFilter) Filter() Filter {
+	return Filter{
+		Property: "tag:" + t.Key,
+		Type:     t.Type,
+		Value:    t.Value,
+	}
+}
+
 func NewExactFilter(value string) Filter {
 	return Filter{
 		Type:  FilterTypeExact,
@@ -26,6 +26,11 @@ func TestUnmarshalFilter(t *testing.T) {
 			match:    []string{"foo"},
 			mismatch: []string{"fo", "fooo", "o", "fo"},
 		},
+		{
+			yaml:     `{"type":"exact","value":"Temp-Stack","case-insensitive":"true"}`,
+			match:    []string{"Temp-Stack", "temp-stack", "TEMP-STACK"},
+			mismatch: []string{"temp-stack-1", "temp"},
+		},
 		{
 			yaml:     `{"type":"glob","value":"b*sh"}`,
 			match:    []string{"bish", "bash", "bosh", "bush", "boooooosh", "bsh"},
@@ -44,8 +49,48 @@ func TestUnmarshalFilter(t *testing.T) {
 		{
 			yaml:     `{"type":"contains","value":"mba"}`,
 			match:    []string{"bimbaz", "mba", "bi mba z"},
+			mismatch: []string{"bim-baz", "biMBAz"},
+		},
+		{
+			yaml:     `{"type":"contains","value":"mba","case-insensitive":"true"}`,
+			match:    []string{"bimbaz", "biMBAz"},
 			mismatch: []string{"bim-baz"},
 		},
+		{
+			yaml:     `{"type":"prefix","value":"ci-"}`,
+			match:    []string{"ci-", "ci-stack", "ci-ci-"},
+			mismatch: []string{"CI-stack", "my-ci-stack", "ci"},
+		},
+		{
+			yaml:     `{"type":"prefix","value":"ci-","case-insensitive":"true"}`,
+			match:    []string{"ci-stack", "CI-stack", "Ci-Stack"},
+			mismatch: []string{"my-ci-stack", "ci"},
+		},
+		{
+			yaml:     `{"type":"suffix","value":"-temp"}`,
+			match:    []string{"-temp", "stack-temp"},
+			mismatch: []string{"stack-TEMP", "stack-temp-1", "temp"},
+		},
+		{
+			yaml:     `{"type":"suffix","value":"-temp","case-insensitive":"true"}`,
+			match:    []string{"stack-temp", "stack-TEMP"},
+			mismatch: []string{"stack-temp-1", "temp"},
+		},
+		{
+			yaml:     `{"type":"numericGreaterThan","value":"10"}`,
+			match:    []string{"11", "10.5", "1e3"},
+			mismatch: []string{"10", "9", "-20", ""},
+		},
+		{
+			yaml:     `{"type":"numericLessThan","value":"10"}`,
+			match:    []string{"9", "9.99", "-20", " 5"},
+			mismatch: []string{"10", "11", ""},
+		},
+		{
+			yaml:     `{"type":"numericEquals","value":"443"}`,
+			match:    []string{"443", "443.0"},
+			mismatch: []string{"80", "4430", ""},
+		},
 		{
 			yaml: `{"type":"dateOlderThan","value":"0"}`,
 			match: []string{strconv.Itoa(int(future.Unix())),
@@ -100,3 +145,119 @@ func TestUnmarshalFilter(t *testing.T) {
 	}
 
 }
+
+func TestFilterInvalidDate(t *testing.T) {
+	cases := []struct {
+		yaml     string
+		input    string
+		match    bool
+		hasError bool
+	}{
+		{yaml: `{"type":"dateOlderThan","value":"1h"}`, input: "", match: false},
+		{yaml: `{"type":"dateOlderThan","value":"1h"}`, input: "garbage", hasError: true},
+		{yaml: `{"type":"dateOlderThan","value":"1h","on-invalid-date":"match"}`, input: "", match: true},
+		{yaml: `{"type":"dateOlderThan","value":"1h","on-invalid-date":"match"}`, input: "garbage", match: true},
+		{yaml: `{"type":"dateOlderThan","value":"1h","on-invalid-date":"mismatch"}`, input: "", match: false},
+		{yaml: `{"type":"dateOlderThan","value":"1h","on-invalid-date":"mismatch"}`, input: "garbage", match: false},
+		{yaml: `{"type":"dateOlderThan","value":"1h","on-invalid-date":"foo"}`, input: "garbage", hasError: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.yaml+"/"+tc.input, func(t *testing.T) {
+			var filter config.Filter
+
+			err := yaml.Unmarshal([]byte(tc.yaml), &filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			match, err := filter.Match(tc.input)
+			if tc.hasError {
+				if err == nil {
+					t.Fatal("Expected an error but didn't get one.")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if match != tc.match {
+				t.Errorf("Wrong match result. Want: %t. Have: %t", tc.match, match)
+			}
+		})
+	}
+}
+
+func TestUnmarshalInvalidRegexFilter(t *testing.T) {
+	var filter config.Filter
+
+	err := yaml.Unmarshal([]byte(`{"type":"regex","value":"b[iao"}`), &filter)
+	if err == nil {
+		t.Fatal("Expected an error but didn't get one.")
+	}
+}
+
+func TestUnmarshalInvalidNumericFilter(t *testing.T) {
+	var filter config.Filter
+
+	err := yaml.Unmarshal([]byte(`{"type":"numericLessThan","value":"ten"}`), &filter)
+	if err == nil {
+		t.Fatal("Expected an error but didn't get one.")
+	}
+}
+
+func TestFilterNumericNonNumericProperty(t *testing.T) {
+	filter := config.Filter{Type: config.FilterTypeNumericLessThan, Value: "10"}
+
+	_, err := filter.Match("gp2")
+	if err == nil {
+		t.Fatal("Expected an error but didn't get one.")
+	}
+}
+
+func TestUnmarshalFilterConditions(t *testing.T) {
+	var filter config.Filter
+
+	err := yaml.Unmarshal([]byte(`
+operator: and
+conditions:
+- property: Name
+  type: prefix
+  value: ci-
+- property: CreateTime
+  type: dateOlderThan
+  value: 24h
+  invert: true
+`), &filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if filter.Operator != config.FilterOperatorAnd {
+		t.Errorf("Wrong operator. Want: %v. Got: %v", config.FilterOperatorAnd, filter.Operator)
+	}
+
+	if len(filter.Conditions) != 2 {
+		t.Fatalf("Wrong number of conditions. Want: 2. Got: %d", len(filter.Conditions))
+	}
+
+	if filter.Conditions[1].Property != "CreateTime" || filter.Conditions[1].Invert != "true" {
+		t.Errorf("Wrong condition: %#v", filter.Conditions[1])
+	}
+}
+
+func TestUnmarshalInvalidFilterOperator(t *testing.T) {
+	for _, in := range []string{
+		`{"operator":"xor","conditions":[{"property":"Name","value":"foo"}]}`,
+		`{"conditions":[{"property":"Name","value":"foo"}]}`,
+		`{"operator":"and","property":"Name","value":"foo"}`,
+	} {
+		var filter config.Filter
+
+		err := yaml.Unmarshal([]byte(in), &filter)
+		if err == nil {
+			t.Errorf("Expected an error for %s but didn't get one.", in)
+		}
+	}
+}
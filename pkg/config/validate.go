@@ -0,0 +1,242 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/mb0/glob"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+// accountIDPattern matches AWS account IDs and the pseudo account IDs of
+// custom regions, which do not support STS.
+var accountIDPattern = regexp.MustCompile(`^([0-9]{12}|account-id-of-custom-region-.+)$`)
+
+// Validate checks the config for mistakes, which would otherwise only show up
+// while nuking an account. It does not access AWS. The resourceTypes are the
+// names of all supported resource types. All found problems are returned.
+func (c *Nuke) Validate(resourceTypes []string) []error {
+	v := &validator{
+		base:          resourceTypes,
+		resourceTypes: map[string]bool{},
+		groups:        c.ResourceTypeGroups,
+	}
+	for _, name := range resourceTypes {
+		v.resourceTypes[name] = true
+	}
+
+	if !c.HasBlocklist() {
+		v.errorf("account-blocklist: must contain at least one account ID")
+	}
+	for _, pattern := range c.ResolveBlocklist() {
+		v.accountPattern("account-blocklist", pattern)
+	}
+	for _, pattern := range c.AccountAllowlist {
+		v.accountPattern("account-allowlist", pattern)
+	}
+
+	for _, id := range c.ProtectedAccounts {
+		v.accountID("protected-accounts", id)
+	}
+
+	v.regions(c.Regions, c.CustomEndpoints)
+
+	for name, members := range c.ResourceTypeGroups {
+		path := fmt.Sprintf("resource-type-groups.%s", name)
+		if v.resourceTypes[name] {
+			v.errorf("%s: group must not have the name of a resource type", path)
+		}
+		for _, member := range types.Collection(members).Expand(v.base, nil) {
+			v.resourceType(path, member)
+		}
+	}
+
+	v.collection("resource-types.targets", c.ResourceTypes.Targets)
+	v.collection("resource-types.excludes", c.ResourceTypes.Excludes)
+
+	for id, account := range c.Accounts {
+		path := fmt.Sprintf("accounts.%s", id)
+
+		if id != "__default__" {
+			v.accountID("accounts", id)
+			if c.InBlocklist(id) {
+				v.errorf("%s: account is also in the account-blocklist", path)
+			}
+			if c.IsProtected(id) {
+				v.errorf("%s: account is also in the protected-accounts", path)
+			}
+		}
+
+		v.collection(path+".resource-types.targets", account.ResourceTypes.Targets)
+		v.collection(path+".resource-types.excludes", account.ResourceTypes.Excludes)
+		v.filters(path+".filters", account.Filters)
+
+		for _, preset := range account.Presets {
+			if _, ok := c.Presets[preset]; !ok {
+				v.errorf("%s.presets: preset '%s' does not exist", path, preset)
+			}
+		}
+
+		if _, err := c.AccountFeatureFlags(id); err != nil {
+			v.errorf("%s.feature-flags: %v", path, err)
+		}
+	}
+
+	if c.FeatureFlags.S3DeleteConcurrency < 0 {
+		v.errorf("feature-flags.s3-delete-concurrency: must not be negative")
+	}
+
+	window := c.FeatureFlags.KMSPendingWindowInDays
+	if window != 0 && (window < 7 || window > 30) {
+		v.errorf("feature-flags.kms-pending-window-in-days: must be between 7 and 30")
+	}
+
+	for name, preset := range c.Presets {
+		v.filters(fmt.Sprintf("presets.%s.filters", name), preset.Filters)
+	}
+
+	for i, tagFilter := range c.GlobalTagFilters {
+		path := fmt.Sprintf("global-tag-filters[%d]", i)
+		if tagFilter.Key == "" {
+			v.errorf("%s: key must not be empty", path)
+		}
+		v.filter(path, tagFilter.Filter())
+	}
+
+	for name := range c.DeletionPriorities {
+		v.resourceType("deletion-priorities", name)
+	}
+
+	for name, timeout := range c.DeleteTimeouts {
+		v.resourceType("delete-timeouts", name)
+		if timeout < 0 {
+			v.errorf("delete-timeouts.%s: must not be negative", name)
+		}
+	}
+
+	// Maps are iterated in random order, so the errors get sorted to report
+	// them deterministically.
+	sort.Slice(v.errors, func(i, j int) bool {
+		return v.errors[i].Error() < v.errors[j].Error()
+	})
+
+	return v.errors
+}
+
+type validator struct {
+	base          types.Collection
+	resourceTypes map[string]bool
+	groups        map[string]types.Collection
+	errors        []error
+}
+
+func (v *validator) errorf(format string, args ...interface{}) {
+	v.errors = append(v.errors, fmt.Errorf(format, args...))
+}
+
+func (v *validator) accountID(path, id string) {
+	if !accountIDPattern.MatchString(id) {
+		v.errorf("%s: '%s' is not a valid account ID, which has 12 digits", path, id)
+	}
+}
+
+// accountPattern validates an entry of the block- or allowlist. Entries
+// consisting only of digits must be account IDs. All others are glob patterns
+// for account IDs or aliases.
+func (v *validator) accountPattern(path, pattern string) {
+	if strings.Trim(pattern, "0123456789") == "" {
+		v.accountID(path, pattern)
+		return
+	}
+
+	if _, err := glob.Match(pattern, ""); err != nil {
+		v.errorf("%s: invalid pattern '%s': %v", path, pattern, err)
+	}
+}
+
+func (v *validator) resourceType(path, name string) {
+	if !v.resourceTypes[name] {
+		v.errorf("%s: unknown resource type '%s' (see 'aws-nuke resource-types')", path, name)
+	}
+}
+
+// collection validates the resource types of targets or excludes, after
+// expanding their groups.
+func (v *validator) collection(path string, names []string) {
+	for _, name := range types.Collection(names).Expand(v.base, v.groups) {
+		v.resourceType(path, name)
+	}
+}
+
+func (v *validator) regions(regions []string, customEndpoints CustomEndpoints) {
+	if len(regions) == 0 {
+		v.errorf("regions: must contain at least one region")
+	}
+
+	known := map[string]bool{"global": true, "all": true}
+	for _, partition := range endpoints.DefaultPartitions() {
+		for name := range partition.Regions() {
+			known[name] = true
+		}
+	}
+	for _, custom := range customEndpoints {
+		known[custom.Region] = true
+	}
+
+	for _, region := range regions {
+		pattern := strings.TrimPrefix(region, "!")
+		if strings.ContainsAny(pattern, "*?[") {
+			if _, err := glob.Match(pattern, ""); err != nil {
+				v.errorf("regions: invalid pattern '%s': %v", region, err)
+			}
+			continue
+		}
+
+		if !known[pattern] {
+			v.errorf("regions: unknown region '%s'", region)
+		}
+	}
+}
+
+func (v *validator) filters(path string, filters Filters) {
+	for resourceType, typeFilters := range filters {
+		v.resourceType(path, resourceType)
+		for i, filter := range typeFilters {
+			v.filter(fmt.Sprintf("%s.%s[%d]", path, resourceType, i), filter)
+		}
+	}
+}
+
+func (v *validator) filter(path string, filter Filter) {
+	if len(filter.Conditions) > 0 {
+		for i, condition := range filter.Conditions {
+			v.filter(fmt.Sprintf("%s.conditions[%d]", path, i), condition)
+		}
+		return
+	}
+
+	switch filter.Type {
+	case FilterTypeEmpty, FilterTypeExact, FilterTypeGlob, FilterTypeContains,
+		FilterTypePrefix, FilterTypeSuffix, FilterTypeNumericGreaterThan,
+		FilterTypeNumericLessThan, FilterTypeNumericEquals:
+	case FilterTypeRegex:
+		if _, err := regexp.Compile(filter.Value); err != nil {
+			v.errorf("%s: invalid regex '%s': %v", path, filter.Value, err)
+		}
+	case FilterTypeDateOlderThan:
+		if _, err := filter.Match(""); err != nil {
+			v.errorf("%s: invalid duration '%s': %v", path, filter.Value, err)
+		}
+	default:
+		v.errorf("%s: unknown filter type '%s'", path, filter.Type)
+	}
+
+	switch filter.OnInvalidDate {
+	case InvalidDateDefault, InvalidDateMatch, InvalidDateMismatch:
+	default:
+		v.errorf("%s: unknown on-invalid-date value '%s'", path, filter.OnInvalidDate)
+	}
+}
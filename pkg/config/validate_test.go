@@ -0,0 +1,123 @@
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"gopkg.in/yaml.v2"
+)
+
+func TestValidate(t *testing.T) {
+	resourceTypes := []string{"IAMRole", "S3Bucket", "EC2Instance"}
+
+	cases := []struct {
+		name   string
+		yaml   string
+		errors []string
+	}{
+		{
+			name: "valid",
+			yaml: `
+regions: [eu-west-1, global, "!ap-*"]
+account-blocklist: ["111111111111"]
+accounts:
+  "222222222222":
+    presets: [common]
+    filters:
+      IAMRole:
+      - type: regex
+        value: "^admin-.*$"
+presets:
+  common:
+    filters:
+      S3Bucket:
+      - type: dateOlderThan
+        value: 24h
+deletion-priorities:
+  EC2Instance: 10
+`,
+		},
+		{
+			name: "invalid",
+			yaml: `
+regions: [eu-wast-1]
+account-blocklist: ["1234"]
+resource-types:
+  targets: [S3Buckets]
+accounts:
+  "111111111111":
+    presets: [missing]
+    filters:
+      IAMRoles:
+      - foo
+      IAMRole:
+      - type: starts-with
+        value: foo
+      - type: dateOlderThan
+        value: yesterday
+`,
+			errors: []string{
+				"account-blocklist: '1234' is not a valid account ID",
+				"regions: unknown region 'eu-wast-1'",
+				"resource-types.targets: unknown resource type 'S3Buckets'",
+				"accounts.111111111111.presets: preset 'missing' does not exist",
+				"accounts.111111111111.filters: unknown resource type 'IAMRoles'",
+				"accounts.111111111111.filters.IAMRole[0]: unknown filter type 'starts-with'",
+				"accounts.111111111111.filters.IAMRole[1]: invalid duration 'yesterday'",
+			},
+		},
+		{
+			name: "groups",
+			yaml: `
+regions: [eu-west-1]
+account-blocklist: ["111111111111"]
+resource-type-groups:
+  storage: [S3Bucket, ec2]
+  IAMRole: [IAMRole]
+  broken: [S3Buckets]
+resource-types:
+  targets: [storage, iam]
+  excludes: [rds]
+`,
+			errors: []string{
+				"resource-type-groups.IAMRole: group must not have the name of a resource type",
+				"resource-type-groups.broken: unknown resource type 'S3Buckets'",
+				"resource-types.excludes: unknown resource type 'rds'",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var config Nuke
+			err := yaml.UnmarshalStrict([]byte(tc.yaml), &config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			errs := config.Validate(resourceTypes)
+
+			have := []string{}
+			for _, err := range errs {
+				have = append(have, err.Error())
+			}
+
+			if len(have) != len(tc.errors) {
+				t.Fatalf("Wrong number of errors. Want: %d. Have: %d\n%s",
+					len(tc.errors), len(have), strings.Join(have, "\n"))
+			}
+
+			for _, want := range tc.errors {
+				found := false
+				for _, h := range have {
+					if strings.Contains(h, want) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("Missing error '%s' in:\n%s", want, strings.Join(have, "\n"))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Package metrics exposes the progress of a run in the Prometheus text format.
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// Metrics collects counters about a run. All methods are safe for concurrent
+// use and a nil *Metrics silently discards all updates.
+type Metrics struct {
+	lock sync.Mutex
+
+	start     time.Time
+	states    map[string]int
+	removed   map[string]int
+	apiErrors map[string]int
+}
+
+func New() *Metrics {
+	return &Metrics{
+		start:     time.Now(),
+		states:    map[string]int{},
+		removed:   map[string]int{},
+		apiErrors: map[string]int{},
+	}
+}
+
+// SetStates replaces the number of items per state.
+func (m *Metrics) SetStates(states map[string]int) {
+	if m == nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.states = states
+}
+
+// IncRemoved counts a successfully removed resource of the given type.
+func (m *Metrics) IncRemoved(resourceType string) {
+	if m == nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.removed[resourceType]++
+}
+
+// IncAPIErrors counts a failed request to the given AWS service.
+func (m *Metrics) IncAPIErrors(service string) {
+	if m == nil {
+		return
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.apiErrors[service]++
+}
+
+// Write writes all metrics in the Prometheus text format.
+func (m *Metrics) Write(w io.Writer) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	writeHelp(w, "aws_nuke_run_duration_seconds", "gauge", "Time since the run started.")
+	fmt.Fprintf(w, "aws_nuke_run_duration_seconds %f\n", time.Since(m.start).Seconds())
+
+	writeHelp(w, "aws_nuke_items", "gauge", "Number of resources by their current state.")
+	writeSeries(w, "aws_nuke_items", "state", m.states)
+
+	writeHelp(w, "aws_nuke_removed_total", "counter", "Number of removed resources by resource type.")
+	writeSeries(w, "aws_nuke_removed_total", "resource_type", m.removed)
+
+	writeHelp(w, "aws_nuke_api_errors_total", "counter", "Number of failed AWS API requests by service.")
+	writeSeries(w, "aws_nuke_api_errors_total", "service", m.apiErrors)
+}
+
+func (m *Metrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	m.Write(w)
+}
+
+// Serve starts an HTTP server, which exposes the metrics on /metrics. The
+// returned function shuts the server down.
+func (m *Metrics) Serve(addr string) func() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", m)
+	server := &http.Server{Addr: addr, Handler: mux}
+
+	go func() {
+		err := server.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf("Metrics server failed: %v", err)
+		}
+	}()
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(ctx)
+	}
+}
+
+func writeHelp(w io.Writer, name, kind, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(w, "# TYPE %s %s\n", name, kind)
+}
+
+func writeSeries(w io.Writer, name, label string, values map[string]int) {
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s{%s=%s} %d\n", name, label, strconv.Quote(k), values[k])
+	}
+}
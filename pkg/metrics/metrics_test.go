@@ -0,0 +1,43 @@
+package metrics_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/pkg/metrics"
+)
+
+func TestMetricsWrite(t *testing.T) {
+	m := metrics.New()
+	m.SetStates(map[string]int{"waiting": 2, "finished": 3})
+	m.IncRemoved("S3Bucket")
+	m.IncRemoved("S3Bucket")
+	m.IncRemoved("IAMRole")
+	m.IncAPIErrors("ec2")
+
+	buf := new(bytes.Buffer)
+	m.Write(buf)
+	have := buf.String()
+
+	want := []string{
+		"# TYPE aws_nuke_run_duration_seconds gauge\n",
+		"aws_nuke_items{state=\"finished\"} 3\naws_nuke_items{state=\"waiting\"} 2\n",
+		"aws_nuke_removed_total{resource_type=\"IAMRole\"} 1\naws_nuke_removed_total{resource_type=\"S3Bucket\"} 2\n",
+		"aws_nuke_api_errors_total{service=\"ec2\"} 1\n",
+	}
+
+	for _, w := range want {
+		if !strings.Contains(have, w) {
+			t.Errorf("Output does not contain %q:\n%s", w, have)
+		}
+	}
+}
+
+func TestMetricsNil(t *testing.T) {
+	var m *metrics.Metrics
+
+	m.SetStates(map[string]int{"new": 1})
+	m.IncRemoved("S3Bucket")
+	m.IncAPIErrors("ec2")
+}
@@ -0,0 +1,132 @@
+// Package notify sends a summary of a run to a webhook.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Formats of the webhook payload.
+const (
+	FormatGeneric = "generic"
+	FormatSlack   = "slack"
+)
+
+// maxSlackFailures limits the number of failed resources in a Slack message,
+// so it stays readable.
+const maxSlackFailures = 20
+
+// Summary describes the result of nuking a single account.
+type Summary struct {
+	AccountID    string `json:"account-id"`
+	AccountAlias string `json:"account-alias,omitempty"`
+	DryRun       bool   `json:"dry-run"`
+	Success      bool   `json:"success"`
+	Error        string `json:"error,omitempty"`
+
+	Duration        time.Duration `json:"-"`
+	DurationSeconds float64       `json:"duration-seconds"`
+
+	Finished            int `json:"finished"`
+	Failed              int `json:"failed"`
+	Filtered            int `json:"filtered"`
+	RemovedByDependency int `json:"removed-by-dependency"`
+
+	FailedResources []FailedResource `json:"failed-resources,omitempty"`
+}
+
+// FailedResource is a resource, which could not be removed.
+type FailedResource struct {
+	Region string `json:"region"`
+	Type   string `json:"type"`
+	ID     string `json:"id,omitempty"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// Notifier posts summaries to a webhook.
+type Notifier struct {
+	URL    string
+	Format string
+	Client *http.Client
+}
+
+func New(url, format string) *Notifier {
+	return &Notifier{
+		URL:    url,
+		Format: format,
+		Client: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Send posts the summary to the webhook in the format of the notifier.
+func (n *Notifier) Send(s Summary) error {
+	s.DurationSeconds = s.Duration.Seconds()
+
+	var payload interface{}
+	switch n.Format {
+	case FormatGeneric, "":
+		payload = s
+	case FormatSlack:
+		payload = map[string]string{"text": SlackText(s)}
+	default:
+		return fmt.Errorf("unknown notification format '%s'", n.Format)
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %v", err)
+	}
+
+	resp, err := n.Client.Post(n.URL, "application/json", bytes.NewReader(raw))
+	if err != nil {
+		return fmt.Errorf("failed to send notification: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("failed to send notification: webhook returned %s", resp.Status)
+	}
+
+	return nil
+}
+
+// SlackText formats the summary as message for a Slack incoming webhook.
+func SlackText(s Summary) string {
+	account := s.AccountID
+	if s.AccountAlias != "" {
+		account = fmt.Sprintf("%s (%s)", s.AccountID, s.AccountAlias)
+	}
+
+	result := "succeeded"
+	if !s.Success {
+		result = "failed"
+	}
+
+	mode := ""
+	if s.DryRun {
+		mode = " dry run"
+	}
+
+	lines := []string{fmt.Sprintf(
+		"aws-nuke%s %s for account %s after %s: %d finished, %d failed, %d filtered, %d removed by dependencies.",
+		mode, result, account, s.Duration.Round(time.Second), s.Finished, s.Failed, s.Filtered,
+		s.RemovedByDependency)}
+
+	if s.Error != "" {
+		lines = append(lines, fmt.Sprintf("Error: %s", s.Error))
+	}
+
+	for i, r := range s.FailedResources {
+		if i == maxSlackFailures {
+			lines = append(lines, fmt.Sprintf("... and %d more", len(s.FailedResources)-i))
+			break
+		}
+		lines = append(lines, fmt.Sprintf("• %s - %s - %s: %s", r.Region, r.Type, r.ID, r.Reason))
+	}
+
+	return strings.Join(lines, "\n")
+}
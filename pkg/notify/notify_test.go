@@ -0,0 +1,104 @@
+package notify_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/pkg/notify"
+)
+
+func testSummary() notify.Summary {
+	return notify.Summary{
+		AccountID:    "123456789012",
+		AccountAlias: "sandbox",
+		Success:      false,
+		Error:        "failed",
+		Duration:     90 * time.Second,
+		Finished:     3,
+		Failed:       1,
+		Filtered:     5,
+
+		RemovedByDependency: 2,
+		FailedResources: []notify.FailedResource{
+			{Region: "eu-west-1", Type: "S3Bucket", ID: "foo", Reason: "BucketNotEmpty"},
+		},
+	}
+}
+
+func serve(t *testing.T, status int, body *[]byte) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		*body = raw
+		w.WriteHeader(status)
+	}))
+}
+
+func TestSendGeneric(t *testing.T) {
+	var body []byte
+	server := serve(t, http.StatusOK, &body)
+	defer server.Close()
+
+	err := notify.New(server.URL, notify.FormatGeneric).Send(testSummary())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var have map[string]interface{}
+	err = json.Unmarshal(body, &have)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if have["account-id"] != "123456789012" || have["failed"] != 1.0 || have["duration-seconds"] != 90.0 {
+		t.Errorf("Unexpected payload: %s", body)
+	}
+	if !strings.Contains(string(body), `"reason":"BucketNotEmpty"`) {
+		t.Errorf("Payload does not contain the failed resource: %s", body)
+	}
+}
+
+func TestSendSlack(t *testing.T) {
+	var body []byte
+	server := serve(t, http.StatusOK, &body)
+	defer server.Close()
+
+	err := notify.New(server.URL, notify.FormatSlack).Send(testSummary())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var have map[string]string
+	err = json.Unmarshal(body, &have)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		"aws-nuke failed for account 123456789012 (sandbox) after 1m30s: 3 finished, 1 failed, 5 filtered, 2 removed by dependencies.",
+		"eu-west-1 - S3Bucket - foo: BucketNotEmpty",
+	}
+	for _, w := range want {
+		if !strings.Contains(have["text"], w) {
+			t.Errorf("Text does not contain %q:\n%s", w, have["text"])
+		}
+	}
+}
+
+func TestSendError(t *testing.T) {
+	var body []byte
+	server := serve(t, http.StatusInternalServerError, &body)
+	defer server.Close()
+
+	err := notify.New(server.URL, notify.FormatGeneric).Send(testSummary())
+	if err == nil {
+		t.Fatal("Expected an error for a failing webhook.")
+	}
+}
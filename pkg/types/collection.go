@@ -1,5 +1,7 @@
 package types
 
+import "strings"
+
 type Collection []string
 
 func (c Collection) Intersect(o Collection) Collection {
@@ -41,6 +43,51 @@ func (c Collection) Union(o Collection) Collection {
 	return Collection(result)
 }
 
+// Expand replaces the groups in the collection with their resource types. A
+// group is either one of the given groups or the lowercase name of a service,
+// like "ec2", which stands for all types of the base starting with it. The
+// members of a group can be services as well. Names, which are no group or
+// which expand to nothing, are kept as they are.
+func (c Collection) Expand(base Collection, groups map[string]Collection) Collection {
+	known := base.toMap()
+
+	result := Collection{}
+	for _, name := range c {
+		members, ok := groups[name]
+		if known[name] || !ok {
+			members = Collection{name}
+		}
+
+		expanded := Collection{}
+		for _, member := range members {
+			expanded = expanded.Union(base.service(member))
+		}
+
+		if len(expanded) == 0 {
+			expanded = Collection{name}
+		}
+		result = result.Union(expanded)
+	}
+
+	return result
+}
+
+// service returns all types starting with the given lowercase service name.
+// Other names are returned as they are, if they are part of the collection.
+func (c Collection) service(name string) Collection {
+	if name == "" || name != strings.ToLower(name) {
+		return c.Intersect(Collection{name})
+	}
+
+	result := Collection{}
+	for _, t := range c {
+		if strings.HasPrefix(strings.ToLower(t), name) {
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
 func (c Collection) toMap() map[string]bool {
 	m := map[string]bool{}
 	for _, t := range c {
@@ -48,3 +48,34 @@ func TestSetUnion(t *testing.T) {
 		t.Errorf("Wrong result. Want: %s. Have: %s", want, have)
 	}
 }
+
+func TestCollectionExpand(t *testing.T) {
+	base := types.Collection{"EC2Instance", "EC2VPC", "ECRRepository", "S3Bucket", "S3Object"}
+	groups := map[string]types.Collection{
+		"networking": {"EC2VPC", "s3"},
+		"empty":      {},
+	}
+
+	cases := []struct {
+		names types.Collection
+		want  types.Collection
+	}{
+		{names: types.Collection{"S3Bucket"}, want: types.Collection{"S3Bucket"}},
+		{names: types.Collection{"ec2"}, want: types.Collection{"EC2Instance", "EC2VPC"}},
+		{names: types.Collection{"ec2", "EC2VPC"}, want: types.Collection{"EC2Instance", "EC2VPC"}},
+		{names: types.Collection{"networking"}, want: types.Collection{"EC2VPC", "S3Bucket", "S3Object"}},
+		{names: types.Collection{"empty", "iam", "Unknown"}, want: types.Collection{"empty", "iam", "Unknown"}},
+		{names: types.Collection{}, want: types.Collection{}},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprint(i), func(t *testing.T) {
+			want := fmt.Sprint(tc.want)
+			have := fmt.Sprint(tc.names.Expand(base, groups))
+
+			if want != have {
+				t.Errorf("Wrong result. Want: %s. Have: %s", want, have)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
@@ -90,6 +91,18 @@ func TestPropertiesSetTag(t *testing.T) {
 			value: 42,
 			want:  `[tag:int: "42"]`,
 		},
+		{
+			name:  "time_ptr",
+			key:   aws.String("time"),
+			value: aws.Time(time.Date(2021, 2, 3, 4, 5, 6, 0, time.UTC)),
+			want:  `[tag:time: "2021-02-03T04:05:06Z"]`,
+		},
+		{
+			name:  "nil_time_ptr",
+			key:   aws.String("time"),
+			value: (*time.Time)(nil),
+			want:  `[]`,
+		},
 		{
 			name:  "nil",
 			key:   aws.String("nothing"),
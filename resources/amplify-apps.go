@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/amplify"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type AmplifyApp struct {
+	svc        *amplify.Amplify
+	appID      *string
+	name       *string
+	createTime *time.Time
+	tags       map[string]*string
+}
+
+func init() {
+	register("AmplifyApp", ListAmplifyApps)
+}
+
+func ListAmplifyApps(sess *session.Session) ([]Resource, error) {
+	svc := amplify.New(sess)
+	resources := []Resource{}
+
+	params := &amplify.ListAppsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListApps(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, app := range output.Apps {
+			resources = append(resources, &AmplifyApp{
+				svc:        svc,
+				appID:      app.AppId,
+				name:       app.Name,
+				createTime: app.CreateTime,
+				tags:       app.Tags,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the app together with its branches and backend environments.
+func (f *AmplifyApp) Remove() error {
+	_, err := f.svc.DeleteApp(&amplify.DeleteAppInput{
+		AppId: f.appID,
+	})
+
+	return err
+}
+
+func (f *AmplifyApp) String() string {
+	return *f.appID
+}
+
+func (f *AmplifyApp) Properties() types.Properties {
+	properties := types.NewProperties()
+	for key, value := range f.tags {
+		properties.SetTag(aws.String(key), value)
+	}
+	properties.
+		Set("AppID", f.appID).
+		Set("Name", f.name).
+		Set("CreateTime", f.createTime)
+	return properties
+}
@@ -1,13 +1,19 @@
 package resources
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type AppStreamFleet struct {
-	svc  *appstream.AppStream
-	name *string
+	svc          *appstream.AppStream
+	name         *string
+	state        *string
+	instanceType *string
 }
 
 func init() {
@@ -28,8 +34,10 @@ func ListAppStreamFleets(sess *session.Session) ([]Resource, error) {
 
 		for _, fleet := range output.Fleets {
 			resources = append(resources, &AppStreamFleet{
-				svc:  svc,
-				name: fleet.Name,
+				svc:          svc,
+				name:         fleet.Name,
+				state:        fleet.State,
+				instanceType: fleet.InstanceType,
 			})
 		}
 
@@ -43,16 +51,35 @@ func ListAppStreamFleets(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove stops the fleet and deletes it once it is stopped. The deletion
+// fails as long as the fleet is associated with a stack and gets retried
+// until the AppStreamStackFleetAttachment is removed.
 func (f *AppStreamFleet) Remove() error {
-
-	_, err := f.svc.StopFleet(&appstream.StopFleetInput{
-		Name: f.name,
+	resp, err := f.svc.DescribeFleets(&appstream.DescribeFleetsInput{
+		Names: []*string{f.name},
 	})
-
 	if err != nil {
 		return err
 	}
 
+	if len(resp.Fleets) > 0 {
+		f.state = resp.Fleets[0].State
+	}
+
+	switch aws.StringValue(f.state) {
+	case appstream.FleetStateRunning, appstream.FleetStateStarting:
+		_, err := f.svc.StopFleet(&appstream.StopFleetInput{
+			Name: f.name,
+		})
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("stopping fleet before deletion")
+	case appstream.FleetStateStopping:
+		return fmt.Errorf("waiting for fleet to stop")
+	}
+
 	_, err = f.svc.DeleteFleet(&appstream.DeleteFleetInput{
 		Name: f.name,
 	})
@@ -60,6 +87,13 @@ func (f *AppStreamFleet) Remove() error {
 	return err
 }
 
+func (f *AppStreamFleet) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("State", f.state).
+		Set("InstanceType", f.instanceType)
+}
+
 func (f *AppStreamFleet) String() string {
 	return *f.name
 }
@@ -4,11 +4,13 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type AppStreamImageBuilder struct {
-	svc  *appstream.AppStream
-	name *string
+	svc   *appstream.AppStream
+	name  *string
+	state *string
 }
 
 func init() {
@@ -31,8 +33,9 @@ func ListAppStreamImageBuilders(sess *session.Session) ([]Resource, error) {
 
 		for _, imageBuilder := range output.ImageBuilders {
 			resources = append(resources, &AppStreamImageBuilder{
-				svc:  svc,
-				name: imageBuilder.Name,
+				svc:   svc,
+				name:  imageBuilder.Name,
+				state: imageBuilder.State,
 			})
 		}
 
@@ -55,6 +58,12 @@ func (f *AppStreamImageBuilder) Remove() error {
 	return err
 }
 
+func (f *AppStreamImageBuilder) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("State", f.state)
+}
+
 func (f *AppStreamImageBuilder) String() string {
 	return *f.name
 }
@@ -3,6 +3,7 @@ package resources
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/appstream"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type AppStreamStack struct {
@@ -52,6 +53,11 @@ func (f *AppStreamStack) Remove() error {
 	return err
 }
 
+func (f *AppStreamStack) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name)
+}
+
 func (f *AppStreamStack) String() string {
 	return *f.name
 }
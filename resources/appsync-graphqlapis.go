@@ -9,10 +9,11 @@ import (
 
 // AppSyncGraphqlAPI - An AWS AppSync GraphQL API
 type AppSyncGraphqlAPI struct {
-	svc   *appsync.AppSync
-	apiID *string
-	name  *string
-	tags  map[string]*string
+	svc                *appsync.AppSync
+	apiID              *string
+	name               *string
+	authenticationType *string
+	tags               map[string]*string
 }
 
 func init() {
@@ -36,10 +37,11 @@ func ListAppSyncGraphqlAPIs(sess *session.Session) ([]Resource, error) {
 
 		for _, graphqlAPI := range resp.GraphqlApis {
 			resources = append(resources, &AppSyncGraphqlAPI{
-				svc:   svc,
-				apiID: graphqlAPI.ApiId,
-				name:  graphqlAPI.Name,
-				tags:  graphqlAPI.Tags,
+				svc:                svc,
+				apiID:              graphqlAPI.ApiId,
+				name:               graphqlAPI.Name,
+				authenticationType: graphqlAPI.AuthenticationType,
+				tags:               graphqlAPI.Tags,
 			})
 		}
 
@@ -69,6 +71,7 @@ func (f *AppSyncGraphqlAPI) Properties() types.Properties {
 	}
 	properties.Set("Name", f.name)
 	properties.Set("APIID", f.apiID)
+	properties.Set("AuthenticationType", f.authenticationType)
 	return properties
 }
 
@@ -11,8 +11,11 @@ func init() {
 }
 
 type AthenaNamedQuery struct {
-	svc *athena.Athena
-	id  *string
+	svc       *athena.Athena
+	id        *string
+	name      *string
+	workGroup *string
+	database  *string
 }
 
 func ListAthenaNamedQueries(sess *session.Session) ([]Resource, error) {
@@ -49,12 +52,32 @@ func ListAthenaNamedQueries(sess *session.Session) ([]Resource, error) {
 		}
 	}
 
-	// Create AthenaNamedQuery resource objects
-	for _, id := range namedQueryIDs {
-		resources = append(resources, &AthenaNamedQuery{
-			svc: svc,
-			id:  id,
+	// Create AthenaNamedQuery resource objects. BatchGetNamedQuery accepts
+	// up to 50 IDs.
+	for len(namedQueryIDs) > 0 {
+		n := len(namedQueryIDs)
+		if n > 50 {
+			n = 50
+		}
+
+		output, err := svc.BatchGetNamedQuery(&athena.BatchGetNamedQueryInput{
+			NamedQueryIds: namedQueryIDs[:n],
 		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, query := range output.NamedQueries {
+			resources = append(resources, &AthenaNamedQuery{
+				svc:       svc,
+				id:        query.NamedQueryId,
+				name:      query.Name,
+				workGroup: query.WorkGroup,
+				database:  query.Database,
+			})
+		}
+
+		namedQueryIDs = namedQueryIDs[n:]
 	}
 
 	return resources, err
@@ -70,7 +93,10 @@ func (a *AthenaNamedQuery) Remove() error {
 
 func (a *AthenaNamedQuery) Properties() types.Properties {
 	return types.NewProperties().
-		Set("Id", *a.id)
+		Set("Id", *a.id).
+		Set("Name", a.name).
+		Set("WorkGroup", a.workGroup).
+		Set("Database", a.database)
 }
 
 func (a *AthenaNamedQuery) String() string {
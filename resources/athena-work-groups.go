@@ -16,9 +16,10 @@ func init() {
 }
 
 type AthenaWorkGroup struct {
-	svc  *athena.Athena
-	name *string
-	arn  *string
+	svc   *athena.Athena
+	name  *string
+	state *string
+	arn   *string
 }
 
 func ListAthenaWorkGroups(sess *session.Session) ([]Resource, error) {
@@ -35,13 +36,11 @@ func ListAthenaWorkGroups(sess *session.Session) ([]Resource, error) {
 	region := svc.Config.Region
 
 	// List WorkGroup
-	var workgroupNames []*string
+	var workgroups []*athena.WorkGroupSummary
 	err = svc.ListWorkGroupsPages(
 		&athena.ListWorkGroupsInput{},
 		func(page *athena.ListWorkGroupsOutput, lastPage bool) bool {
-			for _, workgroup := range page.WorkGroups {
-				workgroupNames = append(workgroupNames, workgroup.Name)
-			}
+			workgroups = append(workgroups, page.WorkGroups...)
 			return true
 		},
 	)
@@ -50,10 +49,12 @@ func ListAthenaWorkGroups(sess *session.Session) ([]Resource, error) {
 	}
 
 	// Create AthenaWorkGroup resource objects
-	for _, name := range workgroupNames {
+	for _, workgroup := range workgroups {
+		name := workgroup.Name
 		resources = append(resources, &AthenaWorkGroup{
-			svc:  svc,
-			name: name,
+			svc:   svc,
+			name:  name,
+			state: workgroup.State,
 			// The GetWorkGroup API doesn't return an ARN,
 			// so we need to construct one ourselves
 			arn: aws.String(fmt.Sprintf(
@@ -160,6 +161,7 @@ func (a *AthenaWorkGroup) Filter() error {
 func (a *AthenaWorkGroup) Properties() types.Properties {
 	return types.NewProperties().
 		Set("Name", *a.name).
+		Set("State", a.state).
 		Set("ARN", *a.arn)
 }
 
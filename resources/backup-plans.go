@@ -1,18 +1,21 @@
 package resources
 
 import (
-	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type BackupPlan struct {
-	svc  *backup.Backup
-	id   string
-	name string
-	arn  string
-	tags map[string]*string
+	svc          *backup.Backup
+	id           string
+	name         string
+	arn          string
+	creationDate *time.Time
+	tags         map[string]*string
 }
 
 func init() {
@@ -36,13 +39,18 @@ func ListBackupPlans(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, plan := range output.BackupPlansList {
-			tagsOutput, _ := svc.ListTags(&backup.ListTagsInput{ResourceArn: plan.BackupPlanArn})
+			tagsOutput, err := svc.ListTags(&backup.ListTagsInput{ResourceArn: plan.BackupPlanArn})
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &BackupPlan{
-				svc:  svc,
-				id:   *plan.BackupPlanId,
-				name: *plan.BackupPlanName,
-				arn:  *plan.BackupPlanArn,
-				tags: tagsOutput.Tags,
+				svc:          svc,
+				id:           *plan.BackupPlanId,
+				name:         *plan.BackupPlanName,
+				arn:          *plan.BackupPlanArn,
+				creationDate: plan.CreationDate,
+				tags:         tagsOutput.Tags,
 			})
 		}
 
@@ -60,8 +68,9 @@ func (b *BackupPlan) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("ID", b.id)
 	properties.Set("Name", b.name)
+	properties.Set("CreationDate", b.creationDate)
 	for tagKey, tagValue := range b.tags {
-		properties.Set(fmt.Sprintf("tag:%v", tagKey), *tagValue)
+		properties.SetTag(aws.String(tagKey), tagValue)
 	}
 	return properties
 }
@@ -2,6 +2,9 @@ package resources
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
@@ -11,6 +14,9 @@ type BackupRecoveryPoint struct {
 	svc             *backup.Backup
 	arn             string
 	backupVaultName string
+	resourceType    *string
+	status          *string
+	creationDate    *time.Time
 }
 
 func init() {
@@ -19,24 +25,41 @@ func init() {
 
 func ListBackupRecoveryPoints(sess *session.Session) ([]Resource, error) {
 	svc := backup.New(sess)
-	max_vaults_len := int64(100)
-	params := &backup.ListBackupVaultsInput{
-		MaxResults: &max_vaults_len, // aws default limit on number of backup vaults per account
-	}
-	resp, err := svc.ListBackupVaults(params)
+
+	vaults, err := listBackupVaults(svc)
 	if err != nil {
 		return nil, err
 	}
 
 	resources := make([]Resource, 0)
-	for _, out := range resp.BackupVaultList {
-		recoveryPointsOutput, _ := svc.ListRecoveryPointsByBackupVault(&backup.ListRecoveryPointsByBackupVaultInput{BackupVaultName: out.BackupVaultName})
-		for _, rp := range recoveryPointsOutput.RecoveryPoints {
-			resources = append(resources, &BackupRecoveryPoint{
-				svc:             svc,
-				arn:             *rp.RecoveryPointArn,
-				backupVaultName: *out.BackupVaultName,
-			})
+	for _, out := range vaults {
+		params := &backup.ListRecoveryPointsByBackupVaultInput{
+			BackupVaultName: out.BackupVaultName,
+			MaxResults:      aws.Int64(100),
+		}
+
+		for {
+			recoveryPointsOutput, err := svc.ListRecoveryPointsByBackupVault(params)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, rp := range recoveryPointsOutput.RecoveryPoints {
+				resources = append(resources, &BackupRecoveryPoint{
+					svc:             svc,
+					arn:             *rp.RecoveryPointArn,
+					backupVaultName: *out.BackupVaultName,
+					resourceType:    rp.ResourceType,
+					status:          rp.Status,
+					creationDate:    rp.CreationDate,
+				})
+			}
+
+			if recoveryPointsOutput.NextToken == nil {
+				break
+			}
+
+			params.NextToken = recoveryPointsOutput.NextToken
 		}
 	}
 
@@ -46,6 +69,9 @@ func ListBackupRecoveryPoints(sess *session.Session) ([]Resource, error) {
 func (b *BackupRecoveryPoint) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("BackupVault", b.backupVaultName)
+	properties.Set("ResourceType", b.resourceType)
+	properties.Set("Status", b.status)
+	properties.Set("CreationDate", b.creationDate)
 	return properties
 }
 
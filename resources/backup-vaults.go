@@ -1,17 +1,20 @@
 package resources
 
 import (
-	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type BackupVault struct {
-	svc  *backup.Backup
-	arn  string
-	name string
-	tags map[string]*string
+	svc          *backup.Backup
+	arn          string
+	name         string
+	creationDate *time.Time
+	tags         map[string]*string
 }
 
 func init() {
@@ -20,38 +23,70 @@ func init() {
 
 func ListBackupVaults(sess *session.Session) ([]Resource, error) {
 	svc := backup.New(sess)
-	max_vaults_len := int64(100)
-	params := &backup.ListBackupVaultsInput{
-		MaxResults: &max_vaults_len, // aws default limit on number of backup vaults per account
-	}
-	resp, err := svc.ListBackupVaults(params)
+
+	vaults, err := listBackupVaults(svc)
 	if err != nil {
 		return nil, err
 	}
 
 	resources := make([]Resource, 0)
-	for _, out := range resp.BackupVaultList {
-		tagsOutput, _ := svc.ListTags(&backup.ListTagsInput{ResourceArn: out.BackupVaultArn})
+	for _, out := range vaults {
+		tagsOutput, err := svc.ListTags(&backup.ListTagsInput{ResourceArn: out.BackupVaultArn})
+		if err != nil {
+			return nil, err
+		}
+
 		resources = append(resources, &BackupVault{
-			svc:  svc,
-			name: *out.BackupVaultName,
-			arn:  *out.BackupVaultArn,
-			tags: tagsOutput.Tags,
+			svc:          svc,
+			name:         *out.BackupVaultName,
+			arn:          *out.BackupVaultArn,
+			creationDate: out.CreationDate,
+			tags:         tagsOutput.Tags,
 		})
 	}
 
 	return resources, nil
 }
 
+func listBackupVaults(svc *backup.Backup) ([]*backup.VaultListMember, error) {
+	maxVaultsLen := int64(100)
+	params := &backup.ListBackupVaultsInput{
+		MaxResults: &maxVaultsLen, // aws default limit on number of backup vaults per account
+	}
+	vaults := []*backup.VaultListMember{}
+
+	for {
+		output, err := svc.ListBackupVaults(params)
+		if err != nil {
+			return nil, err
+		}
+
+		vaults = append(vaults, output.BackupVaultList...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return vaults, nil
+}
+
 func (b *BackupVault) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("Name", b.name)
+	properties.Set("CreationDate", b.creationDate)
 	for tagKey, tagValue := range b.tags {
-		properties.Set(fmt.Sprintf("tag:%v", tagKey), *tagValue)
+		properties.SetTag(aws.String(tagKey), tagValue)
 	}
 	return properties
 }
 
+// Remove only succeeds for empty vaults, therefore the deletion gets retried
+// until all recovery points of the vault are removed. The vault named
+// "Default" gets recreated by AWS Backup when it is used again, which is why
+// it is usually worth to filter it instead of removing it on every run.
 func (b *BackupVault) Remove() error {
 	_, err := b.svc.DeleteBackupVault(&backup.DeleteBackupVaultInput{
 		BackupVaultName: &b.name,
@@ -4,11 +4,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type BatchComputeEnvironment struct {
 	svc                    *batch.Batch
 	computeEnvironmentName *string
+	state                  *string
+	status                 *string
 }
 
 func init() {
@@ -30,9 +33,16 @@ func ListBatchComputeEnvironments(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, computeEnvironment := range output.ComputeEnvironments {
+			if aws.StringValue(computeEnvironment.Status) == batch.CEStatusDeleted {
+				// Deleted compute environments are still listed for a while.
+				continue
+			}
+
 			resources = append(resources, &BatchComputeEnvironment{
-				svc: svc,
+				svc:                    svc,
 				computeEnvironmentName: computeEnvironment.ComputeEnvironmentName,
+				state:                  computeEnvironment.State,
+				status:                 computeEnvironment.Status,
 			})
 		}
 
@@ -46,8 +56,11 @@ func ListBatchComputeEnvironments(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the compute environment. Only disabled compute environments,
+// which are not used by a job queue anymore, can be deleted. Therefore
+// BatchComputeEnvironmentState and BatchJobQueue have higher deletion
+// priorities.
 func (f *BatchComputeEnvironment) Remove() error {
-
 	_, err := f.svc.DeleteComputeEnvironment(&batch.DeleteComputeEnvironmentInput{
 		ComputeEnvironment: f.computeEnvironmentName,
 	})
@@ -58,3 +71,10 @@ func (f *BatchComputeEnvironment) Remove() error {
 func (f *BatchComputeEnvironment) String() string {
 	return *f.computeEnvironmentName
 }
+
+func (f *BatchComputeEnvironment) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.computeEnvironmentName).
+		Set("State", f.state).
+		Set("Status", f.status)
+}
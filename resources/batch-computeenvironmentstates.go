@@ -13,6 +13,7 @@ type BatchComputeEnvironmentState struct {
 	svc                    *batch.Batch
 	computeEnvironmentName *string
 	state                  *string
+	status                 *string
 }
 
 func init() {
@@ -35,9 +36,10 @@ func ListBatchComputeEnvironmentStates(sess *session.Session) ([]Resource, error
 
 		for _, computeEnvironment := range output.ComputeEnvironments {
 			resources = append(resources, &BatchComputeEnvironmentState{
-				svc: svc,
+				svc:                    svc,
 				computeEnvironmentName: computeEnvironment.ComputeEnvironmentName,
-				state: computeEnvironment.State,
+				state:                  computeEnvironment.State,
+				status:                 computeEnvironment.Status,
 			})
 		}
 
@@ -52,6 +54,10 @@ func ListBatchComputeEnvironmentStates(sess *session.Session) ([]Resource, error
 }
 
 func (f *BatchComputeEnvironmentState) Remove() error {
+	if strings.ToLower(*f.state) == "disabled" {
+		// The compute environment is still updating, so only wait for it.
+		return nil
+	}
 
 	_, err := f.svc.UpdateComputeEnvironment(&batch.UpdateComputeEnvironmentInput{
 		ComputeEnvironment: f.computeEnvironmentName,
@@ -65,7 +71,15 @@ func (f *BatchComputeEnvironmentState) String() string {
 	return *f.computeEnvironmentName
 }
 
+// Filter treats the compute environment as disabled only after the update is
+// complete, so BatchComputeEnvironment does not try to delete it before.
 func (f *BatchComputeEnvironmentState) Filter() error {
+	switch aws.StringValue(f.status) {
+	case batch.CEStatusDeleting, batch.CEStatusDeleted:
+		return fmt.Errorf("already deleted")
+	case batch.CEStatusUpdating:
+		return nil
+	}
 	if strings.ToLower(*f.state) == "disabled" {
 		return fmt.Errorf("already disabled")
 	}
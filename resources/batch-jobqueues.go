@@ -4,11 +4,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/batch"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type BatchJobQueue struct {
 	svc      *batch.Batch
 	jobQueue *string
+	state    *string
+	status   *string
 }
 
 func init() {
@@ -30,9 +33,16 @@ func ListBatchJobQueues(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, queue := range output.JobQueues {
+			if aws.StringValue(queue.Status) == batch.JQStatusDeleted {
+				// Deleted queues are still listed for a while.
+				continue
+			}
+
 			resources = append(resources, &BatchJobQueue{
 				svc:      svc,
 				jobQueue: queue.JobQueueName,
+				state:    queue.State,
+				status:   queue.Status,
 			})
 		}
 
@@ -46,8 +56,10 @@ func ListBatchJobQueues(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the job queue. Only disabled queues can be deleted, so
+// BatchJobQueueState disables it before with a higher deletion priority. The
+// deletion is asynchronous and the queue is listed until it is DELETED.
 func (f *BatchJobQueue) Remove() error {
-
 	_, err := f.svc.DeleteJobQueue(&batch.DeleteJobQueueInput{
 		JobQueue: f.jobQueue,
 	})
@@ -58,3 +70,10 @@ func (f *BatchJobQueue) Remove() error {
 func (f *BatchJobQueue) String() string {
 	return *f.jobQueue
 }
+
+func (f *BatchJobQueue) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.jobQueue).
+		Set("State", f.state).
+		Set("Status", f.status)
+}
@@ -13,6 +13,7 @@ type BatchJobQueueState struct {
 	svc      *batch.Batch
 	jobQueue *string
 	state    *string
+	status   *string
 }
 
 func init() {
@@ -38,6 +39,7 @@ func ListBatchJobQueueStates(sess *session.Session) ([]Resource, error) {
 				svc:      svc,
 				jobQueue: queue.JobQueueName,
 				state:    queue.State,
+				status:   queue.Status,
 			})
 		}
 
@@ -52,6 +54,10 @@ func ListBatchJobQueueStates(sess *session.Session) ([]Resource, error) {
 }
 
 func (f *BatchJobQueueState) Remove() error {
+	if strings.ToLower(*f.state) == "disabled" {
+		// The queue is still updating, so only wait for it.
+		return nil
+	}
 
 	_, err := f.svc.UpdateJobQueue(&batch.UpdateJobQueueInput{
 		JobQueue: f.jobQueue,
@@ -65,7 +71,15 @@ func (f *BatchJobQueueState) String() string {
 	return *f.jobQueue
 }
 
+// Filter treats the queue as disabled only after the update is complete, so
+// BatchJobQueue does not try to delete it before.
 func (f *BatchJobQueueState) Filter() error {
+	switch aws.StringValue(f.status) {
+	case batch.JQStatusDeleting, batch.JQStatusDeleted:
+		return fmt.Errorf("already deleted")
+	case batch.JQStatusUpdating:
+		return nil
+	}
 	if strings.ToLower(*f.state) == "disabled" {
 		return fmt.Errorf("already disabled")
 	}
@@ -4,11 +4,16 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/cloud9"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type Cloud9Environment struct {
-	svc           *cloud9.Cloud9
-	environmentID *string
+	svc             *cloud9.Cloud9
+	environmentID   *string
+	name            *string
+	ownerArn        *string
+	environmentType *string
+	status          *string
 }
 
 func init() {
@@ -29,11 +34,30 @@ func ListCloud9Environments(sess *session.Session) ([]Resource, error) {
 			return nil, err
 		}
 
-		for _, environmentID := range resp.EnvironmentIds {
-			resources = append(resources, &Cloud9Environment{
-				svc:           svc,
-				environmentID: environmentID,
+		if len(resp.EnvironmentIds) > 0 {
+			// DescribeEnvironments accepts up to 25 IDs, which matches
+			// the page size above.
+			details, err := svc.DescribeEnvironments(&cloud9.DescribeEnvironmentsInput{
+				EnvironmentIds: resp.EnvironmentIds,
 			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, environment := range details.Environments {
+				resource := &Cloud9Environment{
+					svc:             svc,
+					environmentID:   environment.Id,
+					name:            environment.Name,
+					ownerArn:        environment.OwnerArn,
+					environmentType: environment.Type,
+				}
+				if environment.Lifecycle != nil {
+					resource.status = environment.Lifecycle.Status
+				}
+
+				resources = append(resources, resource)
+			}
 		}
 
 		if resp.NextToken == nil {
@@ -46,7 +70,13 @@ func ListCloud9Environments(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the environment. For EC2 environments this also terminates
+// the backing instance, which shows up as an EC2Instance as well until it is
+// gone.
 func (f *Cloud9Environment) Remove() error {
+	if aws.StringValue(f.status) == cloud9.EnvironmentLifecycleStatusDeleting {
+		return nil
+	}
 
 	_, err := f.svc.DeleteEnvironment(&cloud9.DeleteEnvironmentInput{
 		EnvironmentId: f.environmentID,
@@ -55,6 +85,15 @@ func (f *Cloud9Environment) Remove() error {
 	return err
 }
 
+func (f *Cloud9Environment) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ID", f.environmentID).
+		Set("Name", f.name).
+		Set("OwnerArn", f.ownerArn).
+		Set("Type", f.environmentType).
+		Set("Status", f.status)
+}
+
 func (f *Cloud9Environment) String() string {
 	return *f.environmentID
 }
@@ -2,6 +2,7 @@ package resources
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -118,34 +119,7 @@ func (cfs *CloudFormationStack) doRemove() error {
 			StackName: cfs.stack.StackName,
 		})
 	} else if *stack.StackStatus == cloudformation.StackStatusDeleteFailed {
-		logrus.Infof("CloudFormationStack stackName=%s delete failed. Attempting to retain and delete stack", *cfs.stack.StackName)
-		// This means the CFS has undeleteable resources.
-		// In order to move on with nuking, we retain them in the deletion.
-		retainableResources, err := cfs.svc.ListStackResources(&cloudformation.ListStackResourcesInput{
-			StackName: cfs.stack.StackName,
-		})
-		if err != nil {
-			return err
-		}
-
-		retain := make([]*string, 0)
-
-		for _, r := range retainableResources.StackResourceSummaries {
-			if *r.ResourceStatus != cloudformation.ResourceStatusDeleteComplete {
-				retain = append(retain, r.LogicalResourceId)
-			}
-		}
-
-		_, err = cfs.svc.DeleteStack(&cloudformation.DeleteStackInput{
-			StackName:       cfs.stack.StackName,
-			RetainResources: retain,
-		})
-		if err != nil {
-			return err
-		}
-		return cfs.svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{
-			StackName: cfs.stack.StackName,
-		})
+		return cfs.removeFailed()
 	} else {
 		if err := cfs.waitForStackToStabalize(*stack.StackStatus); err != nil {
 			return err
@@ -162,6 +136,65 @@ func (cfs *CloudFormationStack) doRemove() error {
 		}
 	}
 }
+
+// removeFailed deletes a stack in DELETE_FAILED again. The resources that
+// could not be deleted are retained, so the stack itself gets removed, unless
+// the feature flag no-retain-failed-cloudformation-resources is set.
+func (cfs *CloudFormationStack) removeFailed() error {
+	params := &cloudformation.DeleteStackInput{
+		StackName: cfs.stack.StackName,
+	}
+
+	if !cfs.featureFlags.NoRetainFailedCFNResources {
+		logrus.Infof("CloudFormationStack stackName=%s delete failed. Attempting to retain and delete stack", *cfs.stack.StackName)
+		retain, err := cfs.failedResources()
+		if err != nil {
+			return err
+		}
+		params.RetainResources = retain
+	} else {
+		logrus.Warnf("CloudFormationStack stackName=%s delete failed. Retrying without retaining resources", *cfs.stack.StackName)
+	}
+
+	_, err := cfs.svc.DeleteStack(params)
+	if err != nil {
+		return err
+	}
+	return cfs.svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{
+		StackName: cfs.stack.StackName,
+	})
+}
+
+// failedResources returns the logical IDs of all resources of the stack, which
+// are not deleted yet.
+func (cfs *CloudFormationStack) failedResources() ([]*string, error) {
+	params := &cloudformation.ListStackResourcesInput{
+		StackName: cfs.stack.StackName,
+	}
+	retain := make([]*string, 0)
+
+	for {
+		resp, err := cfs.svc.ListStackResources(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range resp.StackResourceSummaries {
+			if *r.ResourceStatus != cloudformation.ResourceStatusDeleteComplete {
+				retain = append(retain, r.LogicalResourceId)
+			}
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		params.NextToken = resp.NextToken
+	}
+
+	return retain, nil
+}
+
 func (cfs *CloudFormationStack) waitForStackToStabalize(currentStatus string) error {
 	switch currentStatus {
 	case cloudformation.StackStatusUpdateInProgress:
@@ -185,9 +218,33 @@ func (cfs *CloudFormationStack) waitForStackToStabalize(currentStatus string) er
 	}
 }
 
+func (cfs *CloudFormationStack) Filter() error {
+	if aws.StringValue(cfs.stack.StackStatus) == cloudformation.StackStatusDeleteComplete {
+		return fmt.Errorf("already deleted")
+	}
+	return nil
+}
+
+// RemovalError reports stacks, whose deletion failed, so they get retried
+// instead of being waited for until the timeout. Stacks in
+// DELETE_IN_PROGRESS are still waited for.
+func (cfs *CloudFormationStack) RemovalError() error {
+	if aws.StringValue(cfs.stack.StackStatus) != cloudformation.StackStatusDeleteFailed {
+		return nil
+	}
+
+	reason := aws.StringValue(cfs.stack.StackStatusReason)
+	if reason == "" {
+		return fmt.Errorf("stack is in %s", cloudformation.StackStatusDeleteFailed)
+	}
+	return fmt.Errorf("stack is in %s: %s", cloudformation.StackStatusDeleteFailed, reason)
+}
+
 func (cfs *CloudFormationStack) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("Name", cfs.stack.StackName)
+	properties.Set("Status", cfs.stack.StackStatus)
+	properties.Set("CreationTime", cfs.stack.CreationTime)
 	for _, tagValue := range cfs.stack.Tags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/golang/mock/gomock"
 	"github.com/rebuy-de/aws-nuke/mocks/mock_cloudformationiface"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -114,6 +115,96 @@ func TestCloudformationStack_Remove_DeleteFailed(t *testing.T) {
 	a.Nil(err)
 }
 
+func TestCloudformationStack_Remove_DeleteFailedWithoutRetain(t *testing.T) {
+	a := assert.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockCloudformation := mock_cloudformationiface.NewMockCloudFormationAPI(ctrl)
+
+	stack := CloudFormationStack{
+		svc: mockCloudformation,
+		stack: &cloudformation.Stack{
+			StackName: aws.String("foobar"),
+		},
+		featureFlags: config.FeatureFlags{
+			NoRetainFailedCFNResources: true,
+		},
+	}
+
+	gomock.InOrder(
+		mockCloudformation.EXPECT().DescribeStacks(gomock.Eq(&cloudformation.DescribeStacksInput{
+			StackName: aws.String("foobar"),
+		})).Return(&cloudformation.DescribeStacksOutput{
+			Stacks: []*cloudformation.Stack{
+				{
+					StackStatus: aws.String(cloudformation.StackStatusDeleteFailed),
+				},
+			},
+		}, nil),
+		mockCloudformation.EXPECT().DeleteStack(gomock.Eq(&cloudformation.DeleteStackInput{
+			StackName: aws.String("foobar"),
+		})).Return(nil, nil),
+		mockCloudformation.EXPECT().WaitUntilStackDeleteComplete(gomock.Eq(&cloudformation.DescribeStacksInput{
+			StackName: aws.String("foobar"),
+		})).Return(nil),
+	)
+
+	err := stack.Remove()
+	a.Nil(err)
+}
+
+func TestCloudformationStack_RemovalError(t *testing.T) {
+	cases := []struct {
+		status string
+		reason string
+		want   string
+	}{
+		{status: cloudformation.StackStatusDeleteInProgress},
+		{status: cloudformation.StackStatusCreateComplete},
+		{status: cloudformation.StackStatusDeleteFailed, want: "stack is in DELETE_FAILED"},
+		{
+			status: cloudformation.StackStatusDeleteFailed,
+			reason: "The following resource(s) failed to delete: [Bucket].",
+			want:   "stack is in DELETE_FAILED: The following resource(s) failed to delete: [Bucket].",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.status, func(t *testing.T) {
+			stack := CloudFormationStack{
+				stack: &cloudformation.Stack{
+					StackName:   aws.String("foobar"),
+					StackStatus: aws.String(tc.status),
+				},
+			}
+			if tc.reason != "" {
+				stack.stack.StackStatusReason = aws.String(tc.reason)
+			}
+
+			err := stack.RemovalError()
+			if tc.want == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.want)
+			}
+		})
+	}
+}
+
+func TestCloudformationStack_Filter(t *testing.T) {
+	stack := CloudFormationStack{
+		stack: &cloudformation.Stack{
+			StackName:   aws.String("foobar"),
+			StackStatus: aws.String(cloudformation.StackStatusDeleteComplete),
+		},
+	}
+	assert.Error(t, stack.Filter())
+
+	stack.stack.StackStatus = aws.String(cloudformation.StackStatusDeleteInProgress)
+	assert.NoError(t, stack.Filter())
+}
+
 // if the stack is currently in delete in progress
 func TestCloudformationStack_Remove_DeleteInProgress(t *testing.T) {
 	a := assert.New(t)
@@ -17,7 +17,7 @@ type CloudFrontDistributionDeployment struct {
 }
 
 func init() {
-	register("CloudFrontDistributionDeployment", ListCloudFrontDistributionDeployments)
+	registerGlobal("CloudFrontDistributionDeployment", ListCloudFrontDistributionDeployments)
 }
 
 func ListCloudFrontDistributionDeployments(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,7 @@ type CloudFrontDistribution struct {
 }
 
 func init() {
-	register("CloudFrontDistribution", ListCloudFrontDistributions)
+	registerGlobal("CloudFrontDistribution", ListCloudFrontDistributions)
 }
 
 func ListCloudFrontDistributions(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,7 @@ type CloudFrontOriginAccessIdentity struct {
 }
 
 func init() {
-    register("CloudFrontOriginAccessIdentity", ListCloudFrontOriginAccessIdentities)
+    registerGlobal("CloudFrontOriginAccessIdentity", ListCloudFrontOriginAccessIdentities)
 }
 
 func ListCloudFrontOriginAccessIdentities(sess *session.Session) ([]Resource, error) {
@@ -1,13 +1,18 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/codebuild"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type CodeBuildProject struct {
 	svc         *codebuild.CodeBuild
 	projectName *string
+	created     *time.Time
+	tags        []*codebuild.Tag
 }
 
 func init() {
@@ -26,11 +31,24 @@ func ListCodeBuildProjects(sess *session.Session) ([]Resource, error) {
 			return nil, err
 		}
 
-		for _, project := range resp.Projects {
-			resources = append(resources, &CodeBuildProject{
-				svc:         svc,
-				projectName: project,
+		// BatchGetProjects accepts up to 100 names, which is also the page
+		// size of ListProjects.
+		if len(resp.Projects) > 0 {
+			details, err := svc.BatchGetProjects(&codebuild.BatchGetProjectsInput{
+				Names: resp.Projects,
 			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, project := range details.Projects {
+				resources = append(resources, &CodeBuildProject{
+					svc:         svc,
+					projectName: project.Name,
+					created:     project.Created,
+					tags:        project.Tags,
+				})
+			}
 		}
 
 		if resp.NextToken == nil {
@@ -55,3 +73,14 @@ func (f *CodeBuildProject) Remove() error {
 func (f *CodeBuildProject) String() string {
 	return *f.projectName
 }
+
+func (f *CodeBuildProject) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.projectName).
+		Set("Created", f.created)
+	return properties
+}
@@ -1,13 +1,17 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/codecommit"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type CodeCommitRepository struct {
 	svc            *codecommit.CodeCommit
 	repositoryName *string
+	creationDate   *time.Time
 }
 
 func init() {
@@ -26,11 +30,34 @@ func ListCodeCommitRepositories(sess *session.Session) ([]Resource, error) {
 			return nil, err
 		}
 
+		names := []*string{}
 		for _, repository := range resp.Repositories {
-			resources = append(resources, &CodeCommitRepository{
-				svc:            svc,
-				repositoryName: repository.RepositoryName,
+			names = append(names, repository.RepositoryName)
+		}
+
+		// BatchGetRepositories accepts up to 25 names.
+		for len(names) > 0 {
+			n := len(names)
+			if n > 25 {
+				n = 25
+			}
+
+			details, err := svc.BatchGetRepositories(&codecommit.BatchGetRepositoriesInput{
+				RepositoryNames: names[:n],
 			})
+			if err != nil {
+				return nil, err
+			}
+
+			for _, repository := range details.Repositories {
+				resources = append(resources, &CodeCommitRepository{
+					svc:            svc,
+					repositoryName: repository.RepositoryName,
+					creationDate:   repository.CreationDate,
+				})
+			}
+
+			names = names[n:]
 		}
 
 		if resp.NextToken == nil {
@@ -55,3 +82,9 @@ func (f *CodeCommitRepository) Remove() error {
 func (f *CodeCommitRepository) String() string {
 	return *f.repositoryName
 }
+
+func (f *CodeCommitRepository) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.repositoryName).
+		Set("CreationDate", f.creationDate)
+}
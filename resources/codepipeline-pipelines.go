@@ -1,13 +1,17 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/codepipeline"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type CodePipelinePipeline struct {
 	svc          *codepipeline.CodePipeline
 	pipelineName *string
+	created      *time.Time
 }
 
 func init() {
@@ -30,6 +34,7 @@ func ListCodePipelinePipelines(sess *session.Session) ([]Resource, error) {
 			resources = append(resources, &CodePipelinePipeline{
 				svc:          svc,
 				pipelineName: pipeline.Name,
+				created:      pipeline.Created,
 			})
 		}
 
@@ -55,3 +60,9 @@ func (f *CodePipelinePipeline) Remove() error {
 func (f *CodePipelinePipeline) String() string {
 	return *f.pipelineName
 }
+
+func (f *CodePipelinePipeline) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.pipelineName).
+		Set("Created", f.created)
+}
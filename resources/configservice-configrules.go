@@ -3,11 +3,14 @@ package resources
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type ConfigServiceConfigRule struct {
-	svc            *configservice.ConfigService
-	configRuleName *string
+	svc             *configservice.ConfigService
+	configRuleName  *string
+	configRuleState *string
+	createdBy       *string
 }
 
 func init() {
@@ -28,8 +31,10 @@ func ListConfigServiceConfigRules(sess *session.Session) ([]Resource, error) {
 
 		for _, configRule := range output.ConfigRules {
 			resources = append(resources, &ConfigServiceConfigRule{
-				svc:            svc,
-				configRuleName: configRule.ConfigRuleName,
+				svc:             svc,
+				configRuleName:  configRule.ConfigRuleName,
+				configRuleState: configRule.ConfigRuleState,
+				createdBy:       configRule.CreatedBy,
 			})
 		}
 
@@ -55,3 +60,10 @@ func (f *ConfigServiceConfigRule) Remove() error {
 func (f *ConfigServiceConfigRule) String() string {
 	return *f.configRuleName
 }
+
+func (f *ConfigServiceConfigRule) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.configRuleName).
+		Set("State", f.configRuleState).
+		Set("CreatedBy", f.createdBy)
+}
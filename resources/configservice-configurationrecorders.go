@@ -1,13 +1,17 @@
 package resources
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type ConfigServiceConfigurationRecorder struct {
 	svc                       *configservice.ConfigService
 	configurationRecorderName *string
+	recording                 *bool
+	lastStatus                *string
 }
 
 func init() {
@@ -23,18 +27,46 @@ func ListConfigServiceConfigurationRecorders(sess *session.Session) ([]Resource,
 		return nil, err
 	}
 
+	statusResp, err := svc.DescribeConfigurationRecorderStatus(&configservice.DescribeConfigurationRecorderStatusInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := map[string]*configservice.ConfigurationRecorderStatus{}
+	for _, status := range statusResp.ConfigurationRecordersStatus {
+		statuses[*status.Name] = status
+	}
+
 	resources := make([]Resource, 0)
 	for _, configurationRecorder := range resp.ConfigurationRecorders {
-		resources = append(resources, &ConfigServiceConfigurationRecorder{
-			svc: svc,
+		recorder := &ConfigServiceConfigurationRecorder{
+			svc:                       svc,
 			configurationRecorderName: configurationRecorder.Name,
-		})
+		}
+
+		status, ok := statuses[*configurationRecorder.Name]
+		if ok {
+			recorder.recording = status.Recording
+			recorder.lastStatus = status.LastStatus
+		}
+
+		resources = append(resources, recorder)
 	}
 
 	return resources, nil
 }
 
+// Remove stops the recorder first, because the delivery channel can only be
+// deleted after the recorder is stopped.
 func (f *ConfigServiceConfigurationRecorder) Remove() error {
+	if aws.BoolValue(f.recording) {
+		_, err := f.svc.StopConfigurationRecorder(&configservice.StopConfigurationRecorderInput{
+			ConfigurationRecorderName: f.configurationRecorderName,
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	_, err := f.svc.DeleteConfigurationRecorder(&configservice.DeleteConfigurationRecorderInput{
 		ConfigurationRecorderName: f.configurationRecorderName,
@@ -46,3 +78,10 @@ func (f *ConfigServiceConfigurationRecorder) Remove() error {
 func (f *ConfigServiceConfigurationRecorder) String() string {
 	return *f.configurationRecorderName
 }
+
+func (f *ConfigServiceConfigurationRecorder) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.configurationRecorderName).
+		Set("Recording", f.recording).
+		Set("LastStatus", f.lastStatus)
+}
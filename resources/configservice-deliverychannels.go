@@ -3,6 +3,7 @@ package resources
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/configservice"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type ConfigServiceDeliveryChannel struct {
@@ -43,6 +44,11 @@ func (f *ConfigServiceDeliveryChannel) Remove() error {
 	return err
 }
 
+func (f *ConfigServiceDeliveryChannel) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.deliveryChannelName)
+}
+
 func (f *ConfigServiceDeliveryChannel) String() string {
 	return *f.deliveryChannelName
 }
@@ -0,0 +1,81 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type ConnectInstance struct {
+	svc         *connect.Connect
+	id          *string
+	alias       *string
+	status      *string
+	createdTime *time.Time
+}
+
+func init() {
+	register("ConnectInstance", ListConnectInstances)
+}
+
+func ListConnectInstances(sess *session.Session) ([]Resource, error) {
+	svc := connect.New(sess)
+	resources := []Resource{}
+
+	params := &connect.ListInstancesInput{
+		MaxResults: aws.Int64(10),
+	}
+
+	for {
+		output, err := svc.ListInstances(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, instance := range output.InstanceSummaryList {
+			resources = append(resources, &ConnectInstance{
+				svc:         svc,
+				id:          instance.Id,
+				alias:       instance.InstanceAlias,
+				status:      instance.InstanceStatus,
+				createdTime: instance.CreatedTime,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the instance. The deletion takes a while and the instance
+// stays in the list until it is done.
+func (f *ConnectInstance) Remove() error {
+	_, err := f.svc.DeleteInstance(&connect.DeleteInstanceInput{
+		InstanceId: f.id,
+	})
+
+	return err
+}
+
+func (f *ConnectInstance) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("ID", f.id)
+	properties.Set("Alias", f.alias)
+	properties.Set("Status", f.status)
+	if f.createdTime != nil {
+		properties.Set("CreatedTime", f.createdTime.Format(time.RFC3339))
+	}
+	return properties
+}
+
+func (f *ConnectInstance) String() string {
+	return *f.id
+}
@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type DataSyncAgent struct {
+	svc          *datasync.DataSync
+	arn          *string
+	name         *string
+	status       *string
+	creationTime *time.Time
+}
+
+func init() {
+	register("DataSyncAgent", ListDataSyncAgents)
+}
+
+func ListDataSyncAgents(sess *session.Session) ([]Resource, error) {
+	svc := datasync.New(sess)
+	resources := []Resource{}
+
+	params := &datasync.ListAgentsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListAgents(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, agent := range output.Agents {
+			resp, err := svc.DescribeAgent(&datasync.DescribeAgentInput{
+				AgentArn: agent.AgentArn,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, &DataSyncAgent{
+				svc:          svc,
+				arn:          agent.AgentArn,
+				name:         agent.Name,
+				status:       agent.Status,
+				creationTime: resp.CreationTime,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the agent. Agents back the NFS, SMB and object storage
+// locations, so those locations should be deleted first.
+func (f *DataSyncAgent) Remove() error {
+	_, err := f.svc.DeleteAgent(&datasync.DeleteAgentInput{
+		AgentArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *DataSyncAgent) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("Name", f.name)
+	properties.Set("ARN", f.arn)
+	properties.Set("Status", f.status)
+	if f.creationTime != nil {
+		properties.Set("CreationTime", f.creationTime.Format(time.RFC3339))
+	}
+	return properties
+}
+
+func (f *DataSyncAgent) String() string {
+	return *f.arn
+}
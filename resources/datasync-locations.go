@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type DataSyncLocation struct {
+	svc *datasync.DataSync
+	arn *string
+	uri *string
+}
+
+func init() {
+	register("DataSyncLocation", ListDataSyncLocations)
+}
+
+func ListDataSyncLocations(sess *session.Session) ([]Resource, error) {
+	svc := datasync.New(sess)
+	resources := []Resource{}
+
+	params := &datasync.ListLocationsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListLocations(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, location := range output.Locations {
+			resources = append(resources, &DataSyncLocation{
+				svc: svc,
+				arn: location.LocationArn,
+				uri: location.LocationUri,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the location. It fails as long as a DataSyncTask uses it
+// and gets retried until the task is gone.
+func (f *DataSyncLocation) Remove() error {
+	_, err := f.svc.DeleteLocation(&datasync.DeleteLocationInput{
+		LocationArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *DataSyncLocation) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ARN", f.arn).
+		Set("URI", f.uri)
+}
+
+func (f *DataSyncLocation) String() string {
+	return *f.arn
+}
@@ -0,0 +1,89 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type DataSyncTask struct {
+	svc          *datasync.DataSync
+	arn          *string
+	name         *string
+	status       *string
+	creationTime *time.Time
+}
+
+func init() {
+	register("DataSyncTask", ListDataSyncTasks)
+}
+
+func ListDataSyncTasks(sess *session.Session) ([]Resource, error) {
+	svc := datasync.New(sess)
+	resources := []Resource{}
+
+	params := &datasync.ListTasksInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListTasks(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, task := range output.Tasks {
+			resp, err := svc.DescribeTask(&datasync.DescribeTaskInput{
+				TaskArn: task.TaskArn,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, &DataSyncTask{
+				svc:          svc,
+				arn:          task.TaskArn,
+				name:         task.Name,
+				status:       task.Status,
+				creationTime: resp.CreationTime,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the task. Tasks reference their source and destination
+// locations, so they have to be gone before the DataSyncLocation can be
+// deleted.
+func (f *DataSyncTask) Remove() error {
+	_, err := f.svc.DeleteTask(&datasync.DeleteTaskInput{
+		TaskArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *DataSyncTask) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("Name", f.name)
+	properties.Set("ARN", f.arn)
+	properties.Set("Status", f.status)
+	if f.creationTime != nil {
+		properties.Set("CreationTime", f.creationTime.Format(time.RFC3339))
+	}
+	return properties
+}
+
+func (f *DataSyncTask) String() string {
+	return *f.arn
+}
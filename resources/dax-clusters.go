@@ -4,11 +4,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dax"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type DAXCluster struct {
 	svc         *dax.DAX
 	clusterName *string
+	status      *string
+	totalNodes  *int64
 }
 
 func init() {
@@ -33,6 +36,8 @@ func ListDAXClusters(sess *session.Session) ([]Resource, error) {
 			resources = append(resources, &DAXCluster{
 				svc:         svc,
 				clusterName: cluster.ClusterName,
+				status:      cluster.Status,
+				totalNodes:  cluster.TotalNodes,
 			})
 		}
 
@@ -46,7 +51,12 @@ func ListDAXClusters(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the cluster. The subnet and parameter groups of the cluster
+// can only be deleted after it is gone.
 func (f *DAXCluster) Remove() error {
+	if aws.StringValue(f.status) == "deleting" {
+		return nil
+	}
 
 	_, err := f.svc.DeleteCluster(&dax.DeleteClusterInput{
 		ClusterName: f.clusterName,
@@ -55,6 +65,13 @@ func (f *DAXCluster) Remove() error {
 	return err
 }
 
+func (f *DAXCluster) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.clusterName).
+		Set("Status", f.status).
+		Set("TotalNodes", f.totalNodes)
+}
+
 func (f *DAXCluster) String() string {
 	return *f.clusterName
 }
@@ -0,0 +1,76 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type DetectiveGraph struct {
+	svc         *detective.Detective
+	arn         *string
+	createdTime *time.Time
+}
+
+func init() {
+	register("DetectiveGraph", ListDetectiveGraphs)
+}
+
+// ListDetectiveGraphs only returns the graphs of which the account is the
+// administrator. Memberships in graphs of other accounts are handled by
+// DetectiveMembership.
+func ListDetectiveGraphs(sess *session.Session) ([]Resource, error) {
+	svc := detective.New(sess)
+	resources := []Resource{}
+
+	params := &detective.ListGraphsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListGraphs(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, graph := range output.GraphList {
+			resources = append(resources, &DetectiveGraph{
+				svc:         svc,
+				arn:         graph.Arn,
+				createdTime: graph.CreatedTime,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+func (f *DetectiveGraph) Remove() error {
+	_, err := f.svc.DeleteGraph(&detective.DeleteGraphInput{
+		GraphArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *DetectiveGraph) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("ARN", f.arn)
+	if f.createdTime != nil {
+		properties.Set("CreatedTime", f.createdTime.Format(time.RFC3339))
+	}
+	return properties
+}
+
+func (f *DetectiveGraph) String() string {
+	return *f.arn
+}
@@ -0,0 +1,85 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type DetectiveMembership struct {
+	svc             *detective.Detective
+	graphArn        *string
+	administratorID *string
+	status          *string
+}
+
+func init() {
+	register("DetectiveMembership", ListDetectiveMemberships)
+}
+
+// ListDetectiveMemberships returns the graphs of other accounts, which this
+// account is a member of. Member accounts cannot delete these graphs, but
+// can leave them.
+func ListDetectiveMemberships(sess *session.Session) ([]Resource, error) {
+	svc := detective.New(sess)
+	resources := []Resource{}
+
+	params := &detective.ListInvitationsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListInvitations(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, invitation := range output.Invitations {
+			resources = append(resources, &DetectiveMembership{
+				svc:             svc,
+				graphArn:        invitation.GraphArn,
+				administratorID: invitation.MasterId,
+				status:          invitation.Status,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+func (f *DetectiveMembership) Filter() error {
+	switch aws.StringValue(f.status) {
+	case detective.MemberStatusEnabled, detective.MemberStatusAcceptedButDisabled:
+		return nil
+	}
+
+	return fmt.Errorf("invitation was not accepted")
+}
+
+func (f *DetectiveMembership) Remove() error {
+	_, err := f.svc.DisassociateMembership(&detective.DisassociateMembershipInput{
+		GraphArn: f.graphArn,
+	})
+
+	return err
+}
+
+func (f *DetectiveMembership) Properties() types.Properties {
+	return types.NewProperties().
+		Set("GraphARN", f.graphArn).
+		Set("AdministratorID", f.administratorID).
+		Set("Status", f.status)
+}
+
+func (f *DetectiveMembership) String() string {
+	return *f.graphArn
+}
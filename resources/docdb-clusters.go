@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type DocDBCluster struct {
+	svc                *docdb.DocDB
+	ID                 *string
+	engine             *string
+	status             *string
+	deletionProtection *bool
+}
+
+func init() {
+	register("DocDBCluster", ListDocDBClusters)
+}
+
+func ListDocDBClusters(sess *session.Session) ([]Resource, error) {
+	svc := docdb.New(sess)
+	resources := []Resource{}
+
+	// The API is shared with RDS, therefore it also returns the clusters of
+	// other engines.
+	params := &docdb.DescribeDBClustersInput{
+		MaxRecords: aws.Int64(100),
+		Filters: []*docdb.Filter{{
+			Name:   aws.String("engine"),
+			Values: []*string{aws.String("docdb")},
+		}},
+	}
+
+	for {
+		output, err := svc.DescribeDBClusters(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dbCluster := range output.DBClusters {
+			resources = append(resources, &DocDBCluster{
+				svc:                svc,
+				ID:                 dbCluster.DBClusterIdentifier,
+				engine:             dbCluster.Engine,
+				status:             dbCluster.Status,
+				deletionProtection: dbCluster.DeletionProtection,
+			})
+		}
+
+		if output.Marker == nil {
+			break
+		}
+
+		params.Marker = output.Marker
+	}
+
+	return resources, nil
+}
+
+func (f *DocDBCluster) Remove() error {
+	if aws.BoolValue(f.deletionProtection) {
+		_, err := f.svc.ModifyDBCluster(&docdb.ModifyDBClusterInput{
+			DBClusterIdentifier: f.ID,
+			DeletionProtection:  aws.Bool(false),
+			ApplyImmediately:    aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := f.svc.DeleteDBCluster(&docdb.DeleteDBClusterInput{
+		DBClusterIdentifier: f.ID,
+		SkipFinalSnapshot:   aws.Bool(true),
+	})
+
+	return err
+}
+
+func (f *DocDBCluster) String() string {
+	return *f.ID
+}
+
+func (f *DocDBCluster) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Identifier", f.ID).
+		Set("Engine", f.engine).
+		Set("Status", f.status).
+		Set("DeletionProtection", f.deletionProtection)
+}
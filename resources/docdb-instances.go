@@ -0,0 +1,80 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/docdb"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type DocDBInstance struct {
+	svc       *docdb.DocDB
+	ID        *string
+	clusterID *string
+	engine    *string
+	status    *string
+}
+
+func init() {
+	register("DocDBInstance", ListDocDBInstances)
+}
+
+func ListDocDBInstances(sess *session.Session) ([]Resource, error) {
+	svc := docdb.New(sess)
+	resources := []Resource{}
+
+	// The API is shared with RDS, therefore it also returns the instances of
+	// other engines.
+	params := &docdb.DescribeDBInstancesInput{
+		MaxRecords: aws.Int64(100),
+		Filters: []*docdb.Filter{{
+			Name:   aws.String("engine"),
+			Values: []*string{aws.String("docdb")},
+		}},
+	}
+
+	for {
+		output, err := svc.DescribeDBInstances(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dbInstance := range output.DBInstances {
+			resources = append(resources, &DocDBInstance{
+				svc:       svc,
+				ID:        dbInstance.DBInstanceIdentifier,
+				clusterID: dbInstance.DBClusterIdentifier,
+				engine:    dbInstance.Engine,
+				status:    dbInstance.DBInstanceStatus,
+			})
+		}
+
+		if output.Marker == nil {
+			break
+		}
+
+		params.Marker = output.Marker
+	}
+
+	return resources, nil
+}
+
+func (f *DocDBInstance) Remove() error {
+	_, err := f.svc.DeleteDBInstance(&docdb.DeleteDBInstanceInput{
+		DBInstanceIdentifier: f.ID,
+	})
+
+	return err
+}
+
+func (f *DocDBInstance) String() string {
+	return *f.ID
+}
+
+func (f *DocDBInstance) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Identifier", f.ID).
+		Set("ClusterIdentifier", f.clusterID).
+		Set("Engine", f.engine).
+		Set("Status", f.status)
+}
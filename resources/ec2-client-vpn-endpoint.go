@@ -55,6 +55,8 @@ func (c *EC2ClientVpnEndpoint) Remove() error {
 func (e *EC2ClientVpnEndpoint) Properties() types.Properties {
 	properties := types.NewProperties()
 	for _, tagValue := range e.cveTags {
+		properties.SetTag(tagValue.Key, tagValue.Value)
+		// The prefixed tags are kept for existing filters.
 		properties.SetTagWithPrefix("cve", tagValue.Key, tagValue.Value)
 	}
 	return properties
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
@@ -31,18 +32,42 @@ func ListEC2Addresses(sess *session.Session) ([]Resource, error) {
 		resources = append(resources, &EC2Address{
 			svc: svc,
 			eip: out,
-			id:  *out.AllocationId,
-			ip:  *out.PublicIp,
+			id:  aws.StringValue(out.AllocationId),
+			ip:  aws.StringValue(out.PublicIp),
 		})
 	}
 
 	return resources, nil
 }
 
+// Remove disassociates the address first, if it is still associated. VPC
+// addresses are identified by their allocation ID, while EC2-Classic
+// addresses only have their public IP.
 func (e *EC2Address) Remove() error {
-	_, err := e.svc.ReleaseAddress(&ec2.ReleaseAddressInput{
-		AllocationId: &e.id,
-	})
+	isVPC := aws.StringValue(e.eip.Domain) == ec2.DomainTypeVpc
+
+	if e.eip.AssociationId != nil || (!isVPC && e.eip.InstanceId != nil) {
+		params := &ec2.DisassociateAddressInput{}
+		if isVPC {
+			params.AssociationId = e.eip.AssociationId
+		} else {
+			params.PublicIp = e.eip.PublicIp
+		}
+
+		_, err := e.svc.DisassociateAddress(params)
+		if err != nil {
+			return err
+		}
+	}
+
+	params := &ec2.ReleaseAddressInput{}
+	if isVPC {
+		params.AllocationId = &e.id
+	} else {
+		params.PublicIp = &e.ip
+	}
+
+	_, err := e.svc.ReleaseAddress(params)
 	if err != nil {
 		return err
 	}
@@ -55,10 +80,22 @@ func (e *EC2Address) Properties() types.Properties {
 	for _, tagValue := range e.eip.Tags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
-	properties.Set("AllocationID", e.id)
+	properties.Set("AllocationID", e.eip.AllocationId)
+	properties.Set("PublicIP", e.eip.PublicIp)
+	properties.Set("Domain", e.eip.Domain)
+	properties.Set("PublicIPv4Pool", e.eip.PublicIpv4Pool)
+	properties.Set("AssociationID", e.eip.AssociationId)
+	properties.Set("InstanceID", e.eip.InstanceId)
+	properties.Set("Associated", e.eip.AssociationId != nil || e.eip.InstanceId != nil)
 	return properties
 }
 
+// EstimatedMonthlyCost uses the price of public IPv4 addresses, which applies
+// regardless of whether the address is associated.
+func (e *EC2Address) EstimatedMonthlyCost() (float64, bool) {
+	return elasticIPHourlyPrice * HoursPerMonth, true
+}
+
 func (e *EC2Address) String() string {
 	return e.ip
 }
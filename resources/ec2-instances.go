@@ -108,12 +108,25 @@ func (i *EC2Instance) DisableProtection() error {
 
 func (i *EC2Instance) Properties() types.Properties {
 	properties := types.NewProperties()
+	properties.Set("LaunchTime", i.instance.LaunchTime)
+	properties.Set("InstanceType", i.instance.InstanceType)
 	for _, tagValue := range i.instance.Tags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
 	return properties
 }
 
+// EstimatedMonthlyCost only covers the compute cost of running instances.
+// Attached volumes are estimated as EC2Volume.
+func (i *EC2Instance) EstimatedMonthlyCost() (float64, bool) {
+	if i.instance.State != nil && aws.StringValue(i.instance.State.Name) != ec2.InstanceStateNameRunning {
+		return 0, true
+	}
+
+	price, ok := ec2InstanceHourlyPrices[aws.StringValue(i.instance.InstanceType)]
+	return price * HoursPerMonth, ok
+}
+
 func (i *EC2Instance) String() string {
 	return *i.instance.InstanceId
 }
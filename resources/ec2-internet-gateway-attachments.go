@@ -6,15 +6,18 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type EC2InternetGatewayAttachment struct {
-	svc     *ec2.EC2
-	vpcId   *string
-	vpcTags []*ec2.Tag
-	igwId   *string
-	igwTags []*ec2.Tag
+	svc          *ec2.EC2
+	vpcId        *string
+	vpcTags      []*ec2.Tag
+	igwId        *string
+	igwTags      []*ec2.Tag
+	defaultVPC   bool
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -47,11 +50,12 @@ func ListEC2InternetGatewayAttachments(sess *session.Session) ([]Resource, error
 
 		for _, igw := range resp.InternetGateways {
 			resources = append(resources, &EC2InternetGatewayAttachment{
-				svc:     svc,
-				vpcId:   vpc.VpcId,
-				vpcTags: vpc.Tags,
-				igwId:   igw.InternetGatewayId,
-				igwTags: igw.Tags,
+				svc:        svc,
+				vpcId:      vpc.VpcId,
+				vpcTags:    vpc.Tags,
+				igwId:      igw.InternetGatewayId,
+				igwTags:    igw.Tags,
+				defaultVPC: aws.BoolValue(vpc.IsDefault),
 			})
 		}
 	}
@@ -59,6 +63,17 @@ func ListEC2InternetGatewayAttachments(sess *session.Session) ([]Resource, error
 	return resources, nil
 }
 
+func (e *EC2InternetGatewayAttachment) FeatureFlags(ff config.FeatureFlags) {
+	e.featureFlags = ff
+}
+
+func (e *EC2InternetGatewayAttachment) Filter() error {
+	if e.defaultVPC && !e.featureFlags.DeleteDefaultResources {
+		return fmt.Errorf("internet gateway attachment of the default VPC is protected")
+	}
+	return nil
+}
+
 func (e *EC2InternetGatewayAttachment) Remove() error {
 	params := &ec2.DetachInternetGatewayInput{
 		VpcId:             e.vpcId,
@@ -81,6 +96,7 @@ func (e *EC2InternetGatewayAttachment) Properties() types.Properties {
 	for _, tagValue := range e.vpcTags {
 		properties.SetTagWithPrefix("vpc", tagValue.Key, tagValue.Value)
 	}
+	properties.Set("DefaultVPC", e.defaultVPC)
 	return properties
 }
 
@@ -1,14 +1,20 @@
 package resources
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type EC2InternetGateway struct {
-	svc *ec2.EC2
-	igw *ec2.InternetGateway
+	svc          *ec2.EC2
+	igw          *ec2.InternetGateway
+	defaultVPC   bool
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -18,6 +24,11 @@ func init() {
 func ListEC2InternetGateways(sess *session.Session) ([]Resource, error) {
 	svc := ec2.New(sess)
 
+	defaultVPCID, err := describeDefaultVPCID(svc)
+	if err != nil {
+		return nil, err
+	}
+
 	resp, err := svc.DescribeInternetGateways(nil)
 	if err != nil {
 		return nil, err
@@ -25,15 +36,56 @@ func ListEC2InternetGateways(sess *session.Session) ([]Resource, error) {
 
 	resources := make([]Resource, 0)
 	for _, igw := range resp.InternetGateways {
+		defaultVPC := false
+		for _, attachment := range igw.Attachments {
+			if defaultVPCID != "" && aws.StringValue(attachment.VpcId) == defaultVPCID {
+				defaultVPC = true
+			}
+		}
+
 		resources = append(resources, &EC2InternetGateway{
-			svc: svc,
-			igw: igw,
+			svc:        svc,
+			igw:        igw,
+			defaultVPC: defaultVPC,
 		})
 	}
 
 	return resources, nil
 }
 
+// describeDefaultVPCID returns the ID of the default VPC of the region or an
+// empty string, if there is none.
+func describeDefaultVPCID(svc *ec2.EC2) (string, error) {
+	resp, err := svc.DescribeVpcs(&ec2.DescribeVpcsInput{
+		Filters: []*ec2.Filter{
+			{
+				Name:   aws.String("isDefault"),
+				Values: aws.StringSlice([]string{"true"}),
+			},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	for _, vpc := range resp.Vpcs {
+		return aws.StringValue(vpc.VpcId), nil
+	}
+
+	return "", nil
+}
+
+func (e *EC2InternetGateway) FeatureFlags(ff config.FeatureFlags) {
+	e.featureFlags = ff
+}
+
+func (e *EC2InternetGateway) Filter() error {
+	if e.defaultVPC && !e.featureFlags.DeleteDefaultResources {
+		return fmt.Errorf("internet gateway of the default VPC is protected")
+	}
+	return nil
+}
+
 func (e *EC2InternetGateway) Remove() error {
 	params := &ec2.DeleteInternetGatewayInput{
 		InternetGatewayId: e.igw.InternetGatewayId,
@@ -52,6 +104,7 @@ func (e *EC2InternetGateway) Properties() types.Properties {
 	for _, tagValue := range e.igw.Tags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
+	properties.Set("DefaultVPC", e.defaultVPC)
 	return properties
 }
 
@@ -65,6 +65,10 @@ func (n *EC2NATGateway) Properties() types.Properties {
 	return properties
 }
 
+func (n *EC2NATGateway) EstimatedMonthlyCost() (float64, bool) {
+	return natGatewayHourlyPrice * HoursPerMonth, true
+}
+
 func (n *EC2NATGateway) String() string {
 	return *n.natgw.NatGatewayId
 }
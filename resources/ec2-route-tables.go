@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
@@ -34,6 +37,17 @@ func ListEC2RouteTables(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Filter skips the main route table, since it can only be deleted together
+// with its VPC.
+func (e *EC2RouteTable) Filter() error {
+	for _, association := range e.routeTable.Associations {
+		if aws.BoolValue(association.Main) {
+			return fmt.Errorf("cannot delete main route table")
+		}
+	}
+	return nil
+}
+
 func (e *EC2RouteTable) Remove() error {
 	params := &ec2.DeleteRouteTableInput{
 		RouteTableId: e.routeTable.RouteTableId,
@@ -1,7 +1,6 @@
 package resources
 
 import (
-	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -45,7 +44,7 @@ func ListEC2Snapshots(sess *session.Session) ([]Resource, error) {
 func (e *EC2Snapshot) Properties() types.Properties {
 	properties := types.NewProperties()
 	for _, tagValue := range e.tags {
-		properties.Set(fmt.Sprintf("tag:%v", *tagValue.Key), tagValue.Value)
+		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
 	return properties
 }
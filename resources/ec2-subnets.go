@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type EC2Subnet struct {
-	svc    *ec2.EC2
-	subnet *ec2.Subnet
+	svc          *ec2.EC2
+	subnet       *ec2.Subnet
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -35,6 +40,17 @@ func ListEC2Subnets(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+func (e *EC2Subnet) FeatureFlags(ff config.FeatureFlags) {
+	e.featureFlags = ff
+}
+
+func (e *EC2Subnet) Filter() error {
+	if aws.BoolValue(e.subnet.DefaultForAz) && !e.featureFlags.DeleteDefaultResources {
+		return fmt.Errorf("default subnet is protected")
+	}
+	return nil
+}
+
 func (e *EC2Subnet) Remove() error {
 	params := &ec2.DeleteSubnetInput{
 		SubnetId: e.subnet.SubnetId,
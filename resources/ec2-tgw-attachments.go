@@ -52,6 +52,14 @@ func (e *EC2TGWAttachment) Remove() error {
 		// as part of TGW to delete VPN attachments.
 		return fmt.Errorf("VPN attachment")
 	}
+
+	if *e.tgwa.ResourceType == ec2.TransitGatewayAttachmentResourceTypePeering {
+		_, err := e.svc.DeleteTransitGatewayPeeringAttachment(&ec2.DeleteTransitGatewayPeeringAttachmentInput{
+			TransitGatewayAttachmentId: e.tgwa.TransitGatewayAttachmentId,
+		})
+		return err
+	}
+
 	params := &ec2.DeleteTransitGatewayVpcAttachmentInput{
 		TransitGatewayAttachmentId: e.tgwa.TransitGatewayAttachmentId,
 	}
@@ -78,6 +86,9 @@ func (e *EC2TGWAttachment) Properties() types.Properties {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
 	properties.Set("ID", e.tgwa.TransitGatewayAttachmentId)
+	properties.Set("TransitGatewayID", e.tgwa.TransitGatewayId)
+	properties.Set("ResourceType", e.tgwa.ResourceType)
+	properties.Set("State", e.tgwa.State)
 	return properties
 }
 
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type EC2TGWRouteTable struct {
+	svc        *ec2.EC2
+	routeTable *ec2.TransitGatewayRouteTable
+}
+
+func init() {
+	register("EC2TGWRouteTable", ListEC2TGWRouteTables)
+}
+
+func ListEC2TGWRouteTables(sess *session.Session) ([]Resource, error) {
+	svc := ec2.New(sess)
+	params := &ec2.DescribeTransitGatewayRouteTablesInput{}
+	resources := make([]Resource, 0)
+	for {
+		resp, err := svc.DescribeTransitGatewayRouteTables(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, routeTable := range resp.TransitGatewayRouteTables {
+			resources = append(resources, &EC2TGWRouteTable{
+				svc:        svc,
+				routeTable: routeTable,
+			})
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		params = &ec2.DescribeTransitGatewayRouteTablesInput{
+			NextToken: resp.NextToken,
+		}
+	}
+
+	return resources, nil
+}
+
+func (e *EC2TGWRouteTable) Remove() error {
+	params := &ec2.DeleteTransitGatewayRouteTableInput{
+		TransitGatewayRouteTableId: e.routeTable.TransitGatewayRouteTableId,
+	}
+
+	_, err := e.svc.DeleteTransitGatewayRouteTable(params)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (e *EC2TGWRouteTable) Filter() error {
+	if *e.routeTable.State == "deleted" {
+		return fmt.Errorf("already deleted")
+	}
+
+	if aws.BoolValue(e.routeTable.DefaultAssociationRouteTable) {
+		// The default route table gets deleted together with the transit
+		// gateway.
+		return fmt.Errorf("default route table")
+	}
+
+	return nil
+}
+
+func (e *EC2TGWRouteTable) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tagValue := range e.routeTable.Tags {
+		properties.SetTag(tagValue.Key, tagValue.Value)
+	}
+	properties.
+		Set("ID", e.routeTable.TransitGatewayRouteTableId).
+		Set("TransitGatewayID", e.routeTable.TransitGatewayId).
+		Set("State", e.routeTable.State)
+
+	return properties
+}
+
+func (e *EC2TGWRouteTable) String() string {
+	return *e.routeTable.TransitGatewayRouteTableId
+}
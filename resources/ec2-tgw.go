@@ -46,6 +46,9 @@ func ListEC2TGWs(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove fails as long as the transit gateway has attachments or non-default
+// route tables. These are removed by EC2TGWAttachment and EC2TGWRouteTable
+// and the deletion gets retried.
 func (e *EC2TGW) Remove() error {
 	params := &ec2.DeleteTransitGatewayInput{
 		TransitGatewayId: e.tgw.TransitGatewayId,
@@ -74,7 +77,8 @@ func (e *EC2TGW) Properties() types.Properties {
 	}
 	properties.
 		Set("ID", e.tgw.TransitGatewayId).
-		Set("OwnerId", e.tgw.OwnerId)
+		Set("OwnerId", e.tgw.OwnerId).
+		Set("State", e.tgw.State)
 
 	return properties
 }
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
@@ -41,9 +42,17 @@ func (e *EC2Volume) Remove() error {
 	return err
 }
 
+func (e *EC2Volume) EstimatedMonthlyCost() (float64, bool) {
+	price, ok := ebsVolumeMonthlyPricesPerGB[aws.StringValue(e.volume.VolumeType)]
+	return price * float64(aws.Int64Value(e.volume.Size)), ok
+}
+
 func (e *EC2Volume) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("State", e.volume.State)
+	properties.Set("CreateTime", e.volume.CreateTime)
+	properties.Set("Size", e.volume.Size)
+	properties.Set("VolumeType", e.volume.VolumeType)
 	for _, tagValue := range e.volume.Tags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
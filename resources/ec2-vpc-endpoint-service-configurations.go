@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -8,9 +10,11 @@ import (
 )
 
 type EC2VPCEndpointServiceConfiguration struct {
-	svc  *ec2.EC2
-	id   *string
-	name *string
+	svc   *ec2.EC2
+	id    *string
+	name  *string
+	state *string
+	tags  []*ec2.Tag
 }
 
 func init() {
@@ -33,9 +37,11 @@ func ListEC2VPCEndpointServiceConfigurations(sess *session.Session) ([]Resource,
 
 		for _, serviceConfig := range resp.ServiceConfigurations {
 			resources = append(resources, &EC2VPCEndpointServiceConfiguration{
-				svc:  svc,
-				id:   serviceConfig.ServiceId,
-				name: serviceConfig.ServiceName,
+				svc:   svc,
+				id:    serviceConfig.ServiceId,
+				name:  serviceConfig.ServiceName,
+				state: serviceConfig.ServiceState,
+				tags:  serviceConfig.Tags,
 			})
 		}
 
@@ -54,16 +60,30 @@ func (e *EC2VPCEndpointServiceConfiguration) Remove() error {
 		ServiceIds: []*string{e.id},
 	}
 
-	_, err := e.svc.DeleteVpcEndpointServiceConfigurations(params)
+	resp, err := e.svc.DeleteVpcEndpointServiceConfigurations(params)
 	if err != nil {
 		return err
 	}
+
+	// The batch API reports failures per service instead of returning an
+	// error.
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+		}
+	}
+
 	return nil
 }
 
 func (e *EC2VPCEndpointServiceConfiguration) Properties() types.Properties {
 	properties := types.NewProperties()
+	for _, tagValue := range e.tags {
+		properties.SetTag(tagValue.Key, tagValue.Value)
+	}
+	properties.Set("ID", e.id)
 	properties.Set("Name", e.name)
+	properties.Set("State", e.state)
 	return properties
 }
 
@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type EC2VPC struct {
-	svc       *ec2.EC2
-	vpc       *ec2.Vpc
+	svc          *ec2.EC2
+	vpc          *ec2.Vpc
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -26,14 +31,25 @@ func ListEC2VPCs(sess *session.Session) ([]Resource, error) {
 	resources := make([]Resource, 0)
 	for _, vpc := range resp.Vpcs {
 		resources = append(resources, &EC2VPC{
-			svc:       svc,
-			vpc:       vpc,
+			svc: svc,
+			vpc: vpc,
 		})
 	}
 
 	return resources, nil
 }
 
+func (e *EC2VPC) FeatureFlags(ff config.FeatureFlags) {
+	e.featureFlags = ff
+}
+
+func (e *EC2VPC) Filter() error {
+	if aws.BoolValue(e.vpc.IsDefault) && !e.featureFlags.DeleteDefaultResources {
+		return fmt.Errorf("default VPC is protected")
+	}
+	return nil
+}
+
 func (e *EC2VPC) Remove() error {
 	params := &ec2.DeleteVpcInput{
 		VpcId: e.vpc.VpcId,
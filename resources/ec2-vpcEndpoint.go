@@ -1,16 +1,20 @@
 package resources
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
-	"github.com/aws/aws-sdk-go/aws"
 )
 
 type EC2VPCEndpoint struct {
-	svc     *ec2.EC2
-	id      *string
-	vpcTags []*ec2.Tag
+	svc      *ec2.EC2
+	id       *string
+	endpoint *ec2.VpcEndpoint
+	vpcTags  []*ec2.Tag
 }
 
 func init() {
@@ -25,17 +29,17 @@ func ListEC2VPCEndpoints(sess *session.Session) ([]Resource, error) {
 		return nil, err
 	}
 
-	resources := make([]Resource, 0)
+	vpcTags := map[string][]*ec2.Tag{}
 	for _, vpc := range resp.Vpcs {
-		params := &ec2.DescribeVpcEndpointsInput{
-			Filters: []*ec2.Filter{
-				{
-					Name:   aws.String("vpc-id"),
-					Values: []*string{vpc.VpcId},
-				},
-			},
-		}
+		vpcTags[*vpc.VpcId] = vpc.Tags
+	}
 
+	resources := make([]Resource, 0)
+	params := &ec2.DescribeVpcEndpointsInput{
+		MaxResults: aws.Int64(1000),
+	}
+
+	for {
 		resp, err := svc.DescribeVpcEndpoints(params)
 		if err != nil {
 			return nil, err
@@ -43,34 +47,74 @@ func ListEC2VPCEndpoints(sess *session.Session) ([]Resource, error) {
 
 		for _, vpcEndpoint := range resp.VpcEndpoints {
 			resources = append(resources, &EC2VPCEndpoint{
-				svc:  svc,
-				id:   vpcEndpoint.VpcEndpointId,
-				vpcTags: vpc.Tags,
+				svc:      svc,
+				id:       vpcEndpoint.VpcEndpointId,
+				endpoint: vpcEndpoint,
+				vpcTags:  vpcTags[aws.StringValue(vpcEndpoint.VpcId)],
 			})
 		}
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		params.NextToken = resp.NextToken
 	}
 
 	return resources, nil
 }
 
 func (endpoint *EC2VPCEndpoint) Remove() error {
+	switch strings.ToLower(aws.StringValue(endpoint.endpoint.State)) {
+	case "deleting", "deleted":
+		// The endpoint stays in the list until the deletion is complete.
+		return nil
+	}
+
 	params := &ec2.DeleteVpcEndpointsInput{
 		VpcEndpointIds: []*string{endpoint.id},
 	}
 
-	_, err := endpoint.svc.DeleteVpcEndpoints(params)
+	resp, err := endpoint.svc.DeleteVpcEndpoints(params)
 	if err != nil {
 		return err
 	}
 
+	// The batch API reports failures per endpoint instead of returning an
+	// error.
+	for _, item := range resp.Unsuccessful {
+		if item.Error != nil {
+			return fmt.Errorf("%s: %s", aws.StringValue(item.Error.Code), aws.StringValue(item.Error.Message))
+		}
+	}
+
 	return nil
 }
 
+func (e *EC2VPCEndpoint) Filter() error {
+	if strings.ToLower(aws.StringValue(e.endpoint.State)) == "deleted" {
+		return fmt.Errorf("already deleted")
+	}
+
+	return nil
+}
+
+// Properties contains the tags of the VPC without prefix for backwards
+// compatibility. The tags of the endpoint itself have the prefix "vpce".
 func (e *EC2VPCEndpoint) Properties() types.Properties {
 	properties := types.NewProperties()
 	for _, tagValue := range e.vpcTags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
+	for _, tagValue := range e.endpoint.Tags {
+		properties.SetTagWithPrefix("vpce", tagValue.Key, tagValue.Value)
+	}
+	properties.
+		Set("ID", e.id).
+		Set("ServiceName", e.endpoint.ServiceName).
+		Set("VpcID", e.endpoint.VpcId).
+		Set("Type", e.endpoint.VpcEndpointType).
+		Set("State", e.endpoint.State)
 	return properties
 }
 
@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEC2VPC_FilterDefault(t *testing.T) {
+	a := assert.New(t)
+
+	vpc := EC2VPC{
+		vpc: &ec2.Vpc{
+			VpcId:     aws.String("vpc-123"),
+			IsDefault: aws.Bool(true),
+		},
+	}
+	a.Error(vpc.Filter())
+
+	vpc.FeatureFlags(config.FeatureFlags{DeleteDefaultResources: true})
+	a.Nil(vpc.Filter())
+
+	vpc.vpc.IsDefault = aws.Bool(false)
+	vpc.FeatureFlags(config.FeatureFlags{})
+	a.Nil(vpc.Filter())
+}
+
+func TestEC2Subnet_FilterDefault(t *testing.T) {
+	a := assert.New(t)
+
+	subnet := EC2Subnet{
+		subnet: &ec2.Subnet{
+			SubnetId:     aws.String("subnet-123"),
+			DefaultForAz: aws.Bool(true),
+		},
+	}
+	a.Error(subnet.Filter())
+
+	subnet.FeatureFlags(config.FeatureFlags{DeleteDefaultResources: true})
+	a.Nil(subnet.Filter())
+}
@@ -2,15 +2,22 @@ package resources
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ecr"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type ECRRepository struct {
-	svc  *ecr.ECR
-	name *string
+	svc          *ecr.ECR
+	name         *string
+	arn          *string
+	createdAt    *time.Time
+	tags         []*ecr.Tag
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -32,9 +39,19 @@ func ListECRRepositories(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, repository := range output.Repositories {
+			tags, err := svc.ListTagsForResource(&ecr.ListTagsForResourceInput{
+				ResourceArn: repository.RepositoryArn,
+			})
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &ECRRepository{
-				svc:  svc,
-				name: repository.RepositoryName,
+				svc:       svc,
+				name:      repository.RepositoryName,
+				arn:       repository.RepositoryArn,
+				createdAt: repository.CreatedAt,
+				tags:      tags.Tags,
 			})
 		}
 
@@ -48,19 +65,38 @@ func ListECRRepositories(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+func (r *ECRRepository) FeatureFlags(ff config.FeatureFlags) {
+	r.featureFlags = ff
+}
+
 func (r *ECRRepository) Filter() error {
 	return nil
 }
 
+// Remove deletes the repository together with its images. With the feature
+// flag keep-non-empty-ecr-repositories, repositories that still contain images
+// are not deleted.
 func (r *ECRRepository) Remove() error {
 	params := &ecr.DeleteRepositoryInput{
 		RepositoryName: r.name,
-		Force:          aws.Bool(true),
+		Force:          aws.Bool(!r.featureFlags.KeepNonEmptyECRRepositories),
 	}
 	_, err := r.svc.DeleteRepository(params)
 	return err
 }
 
+func (r *ECRRepository) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range r.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", r.name).
+		Set("ARN", r.arn).
+		Set("CreatedAt", r.createdAt)
+	return properties
+}
+
 func (r *ECRRepository) String() string {
 	return fmt.Sprintf("Repository: %s", *r.name)
 }
@@ -0,0 +1,84 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecrpublic"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type ECRPublicRepository struct {
+	svc          *ecrpublic.ECRPublic
+	name         *string
+	arn          *string
+	createdAt    *time.Time
+	featureFlags config.FeatureFlags
+}
+
+func init() {
+	register("ECRPublicRepository", ListECRPublicRepositories)
+}
+
+// ListECRPublicRepositories lists the public repositories, which are only
+// available in us-east-1.
+func ListECRPublicRepositories(sess *session.Session) ([]Resource, error) {
+	svc := ecrpublic.New(sess)
+	resources := []Resource{}
+
+	input := &ecrpublic.DescribeRepositoriesInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.DescribeRepositories(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, repository := range output.Repositories {
+			resources = append(resources, &ECRPublicRepository{
+				svc:       svc,
+				name:      repository.RepositoryName,
+				arn:       repository.RepositoryArn,
+				createdAt: repository.CreatedAt,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+func (r *ECRPublicRepository) FeatureFlags(ff config.FeatureFlags) {
+	r.featureFlags = ff
+}
+
+// Remove deletes the repository together with its images. With the feature
+// flag keep-non-empty-ecr-repositories, repositories that still contain images
+// are not deleted.
+func (r *ECRPublicRepository) Remove() error {
+	_, err := r.svc.DeleteRepository(&ecrpublic.DeleteRepositoryInput{
+		RepositoryName: r.name,
+		Force:          aws.Bool(!r.featureFlags.KeepNonEmptyECRRepositories),
+	})
+	return err
+}
+
+func (r *ECRPublicRepository) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", r.name).
+		Set("ARN", r.arn).
+		Set("CreatedAt", r.createdAt)
+}
+
+func (r *ECRPublicRepository) String() string {
+	return *r.name
+}
@@ -0,0 +1,79 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type EFSAccessPoint struct {
+	svc            *efs.EFS
+	id             string
+	fsid           string
+	name           *string
+	lifeCycleState *string
+	tags           []*efs.Tag
+}
+
+func init() {
+	register("EFSAccessPoint", ListEFSAccessPoints)
+}
+
+func ListEFSAccessPoints(sess *session.Session) ([]Resource, error) {
+	svc := efs.New(sess)
+	resources := make([]Resource, 0)
+
+	params := &efs.DescribeAccessPointsInput{}
+
+	for {
+		resp, err := svc.DescribeAccessPoints(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ap := range resp.AccessPoints {
+			resources = append(resources, &EFSAccessPoint{
+				svc:            svc,
+				id:             *ap.AccessPointId,
+				fsid:           *ap.FileSystemId,
+				name:           ap.Name,
+				lifeCycleState: ap.LifeCycleState,
+				tags:           ap.Tags,
+			})
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		params.NextToken = resp.NextToken
+	}
+
+	return resources, nil
+}
+
+func (e *EFSAccessPoint) Remove() error {
+	_, err := e.svc.DeleteAccessPoint(&efs.DeleteAccessPointInput{
+		AccessPointId: &e.id,
+	})
+
+	return err
+}
+
+func (e *EFSAccessPoint) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range e.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.Set("AccessPointID", e.id)
+	properties.Set("FileSystemID", e.fsid)
+	properties.Set("Name", e.name)
+	properties.Set("LifeCycleState", e.lifeCycleState)
+	return properties
+}
+
+func (e *EFSAccessPoint) String() string {
+	return fmt.Sprintf("%s:%s", e.fsid, e.id)
+}
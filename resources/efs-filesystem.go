@@ -1,14 +1,18 @@
 package resources
 
 import (
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type EFSFileSystem struct {
-	svc  *efs.EFS
-	id   string
-	name string
+	svc            *efs.EFS
+	id             string
+	name           string
+	lifeCycleState *string
+	tags           []*efs.Tag
 }
 
 func init() {
@@ -18,17 +22,19 @@ func init() {
 func ListEFSFileSystems(sess *session.Session) ([]Resource, error) {
 	svc := efs.New(sess)
 
-	resp, err := svc.DescribeFileSystems(nil)
+	fileSystems, err := listEFSFileSystems(svc)
 	if err != nil {
 		return nil, err
 	}
 
 	resources := make([]Resource, 0)
-	for _, fs := range resp.FileSystems {
+	for _, fs := range fileSystems {
 		resources = append(resources, &EFSFileSystem{
-			svc:  svc,
-			id:   *fs.FileSystemId,
-			name: *fs.CreationToken,
+			svc:            svc,
+			id:             *fs.FileSystemId,
+			name:           *fs.CreationToken,
+			lifeCycleState: fs.LifeCycleState,
+			tags:           fs.Tags,
 		})
 
 	}
@@ -36,7 +42,35 @@ func ListEFSFileSystems(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+func listEFSFileSystems(svc *efs.EFS) ([]*efs.FileSystemDescription, error) {
+	fileSystems := []*efs.FileSystemDescription{}
+	params := &efs.DescribeFileSystemsInput{}
+
+	for {
+		resp, err := svc.DescribeFileSystems(params)
+		if err != nil {
+			return nil, err
+		}
+
+		fileSystems = append(fileSystems, resp.FileSystems...)
+
+		if resp.NextMarker == nil {
+			break
+		}
+
+		params.Marker = resp.NextMarker
+	}
+
+	return fileSystems, nil
+}
+
+// Remove fails as long as the file system has mount targets. These are
+// removed by EFSMountTarget and the deletion gets retried.
 func (e *EFSFileSystem) Remove() error {
+	if aws.StringValue(e.lifeCycleState) == efs.LifeCycleStateDeleting {
+		return nil
+	}
+
 	_, err := e.svc.DeleteFileSystem(&efs.DeleteFileSystemInput{
 		FileSystemId: &e.id,
 	})
@@ -44,6 +78,17 @@ func (e *EFSFileSystem) Remove() error {
 	return err
 }
 
+func (e *EFSFileSystem) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range e.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.Set("FileSystemID", e.id)
+	properties.Set("CreationToken", e.name)
+	properties.Set("LifeCycleState", e.lifeCycleState)
+	return properties
+}
+
 func (e *EFSFileSystem) String() string {
 	return e.name
 }
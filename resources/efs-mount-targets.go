@@ -5,12 +5,14 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/efs"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type EFSMountTarget struct {
-	svc  *efs.EFS
-	id   string
-	fsid string
+	svc            *efs.EFS
+	id             string
+	fsid           string
+	lifeCycleState *string
 }
 
 func init() {
@@ -20,13 +22,13 @@ func init() {
 func ListEFSMountTargets(sess *session.Session) ([]Resource, error) {
 	svc := efs.New(sess)
 
-	resp, err := svc.DescribeFileSystems(nil)
+	fileSystems, err := listEFSFileSystems(svc)
 	if err != nil {
 		return nil, err
 	}
 
 	resources := make([]Resource, 0)
-	for _, fs := range resp.FileSystems {
+	for _, fs := range fileSystems {
 		mt, err := svc.DescribeMountTargets(&efs.DescribeMountTargetsInput{
 			FileSystemId: fs.FileSystemId,
 		})
@@ -37,9 +39,10 @@ func ListEFSMountTargets(sess *session.Session) ([]Resource, error) {
 
 		for _, t := range mt.MountTargets {
 			resources = append(resources, &EFSMountTarget{
-				svc:  svc,
-				id:   *t.MountTargetId,
-				fsid: *t.FileSystemId,
+				svc:            svc,
+				id:             *t.MountTargetId,
+				fsid:           *t.FileSystemId,
+				lifeCycleState: t.LifeCycleState,
 			})
 
 		}
@@ -56,6 +59,14 @@ func (e *EFSMountTarget) Remove() error {
 	return err
 }
 
+func (e *EFSMountTarget) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("MountTargetID", e.id)
+	properties.Set("FileSystemID", e.fsid)
+	properties.Set("LifeCycleState", e.lifeCycleState)
+	return properties
+}
+
 func (e *EFSMountTarget) String() string {
 	return fmt.Sprintf("%s:%s", e.fsid, e.id)
 }
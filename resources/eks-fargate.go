@@ -13,6 +13,7 @@ type EKSFargateProfile struct {
 	svc     *eks.EKS
 	cluster *string
 	name    *string
+	status  *string
 }
 
 func init() {
@@ -61,10 +62,19 @@ func ListEKSFargateProfiles(sess *session.Session) ([]Resource, error) {
 			}
 
 			for _, name := range resp.FargateProfileNames {
+				profile, err := svc.DescribeFargateProfile(&eks.DescribeFargateProfileInput{
+					ClusterName:        clusterName,
+					FargateProfileName: name,
+				})
+				if err != nil {
+					return nil, err
+				}
+
 				resources = append(resources, &EKSFargateProfile{
 					svc:     svc,
 					name:    name,
 					cluster: clusterName,
+					status:  profile.FargateProfile.Status,
 				})
 			}
 
@@ -81,7 +91,14 @@ func ListEKSFargateProfiles(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the Fargate profile. EKS only deletes one profile of a
+// cluster at a time, so the others fail and get retried. The cluster can
+// only be deleted after all of its profiles are gone.
 func (fp *EKSFargateProfile) Remove() error {
+	if aws.StringValue(fp.status) == eks.FargateProfileStatusDeleting {
+		return nil
+	}
+
 	_, err := fp.svc.DeleteFargateProfile(&eks.DeleteFargateProfileInput{
 		ClusterName:        fp.cluster,
 		FargateProfileName: fp.name,
@@ -92,7 +109,8 @@ func (fp *EKSFargateProfile) Remove() error {
 func (fp *EKSFargateProfile) Properties() types.Properties {
 	return types.NewProperties().
 		Set("Cluster", *fp.cluster).
-		Set("Profile", *fp.name)
+		Set("Profile", *fp.name).
+		Set("Status", fp.status)
 }
 
 func (fp *EKSFargateProfile) String() string {
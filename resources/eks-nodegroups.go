@@ -12,6 +12,7 @@ type EKSNodegroup struct {
 	svc     *eks.EKS
 	cluster *string
 	name    *string
+	status  *string
 }
 
 func init() {
@@ -60,10 +61,19 @@ func ListEKSNodegroups(sess *session.Session) ([]Resource, error) {
 			}
 
 			for _, name := range resp.Nodegroups {
+				nodegroup, err := svc.DescribeNodegroup(&eks.DescribeNodegroupInput{
+					ClusterName:   clusterName,
+					NodegroupName: name,
+				})
+				if err != nil {
+					return nil, err
+				}
+
 				resources = append(resources, &EKSNodegroup{
 					svc:     svc,
 					name:    name,
 					cluster: clusterName,
+					status:  nodegroup.Nodegroup.Status,
 				})
 			}
 
@@ -80,7 +90,13 @@ func ListEKSNodegroups(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the node group. The cluster can only be deleted after all
+// of its node groups are gone.
 func (ng *EKSNodegroup) Remove() error {
+	if aws.StringValue(ng.status) == eks.NodegroupStatusDeleting {
+		return nil
+	}
+
 	_, err := ng.svc.DeleteNodegroup(&eks.DeleteNodegroupInput{
 		ClusterName:   ng.cluster,
 		NodegroupName: ng.name,
@@ -91,7 +107,8 @@ func (ng *EKSNodegroup) Remove() error {
 func (ng *EKSNodegroup) Properties() types.Properties {
 	return types.NewProperties().
 		Set("Cluster", *ng.cluster).
-		Set("Profile", *ng.name)
+		Set("Profile", *ng.name).
+		Set("Status", ng.status)
 }
 
 func (ng *EKSNodegroup) String() string {
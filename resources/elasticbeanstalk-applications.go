@@ -3,6 +3,7 @@ package resources
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/elasticbeanstalk"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type ElasticBeanstalkApplication struct {
@@ -35,6 +36,9 @@ func ListElasticBeanstalkApplications(sess *session.Session) ([]Resource, error)
 	return resources, nil
 }
 
+// Remove fails as long as the application has environments, which are not
+// terminated. ElasticBeanstalkEnvironment has a higher deletion priority, so
+// the environments get terminated first.
 func (f *ElasticBeanstalkApplication) Remove() error {
 
 	_, err := f.svc.DeleteApplication(&elasticbeanstalk.DeleteApplicationInput{
@@ -47,3 +51,8 @@ func (f *ElasticBeanstalkApplication) Remove() error {
 func (f *ElasticBeanstalkApplication) String() string {
 	return *f.name
 }
+
+func (f *ElasticBeanstalkApplication) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name)
+}
@@ -8,9 +8,11 @@ import (
 )
 
 type ElasticBeanstalkEnvironment struct {
-	svc  *elasticbeanstalk.ElasticBeanstalk
-	ID   *string
-	name *string
+	svc             *elasticbeanstalk.ElasticBeanstalk
+	ID              *string
+	name            *string
+	applicationName *string
+	status          *string
 }
 
 func init() {
@@ -34,9 +36,11 @@ func ListElasticBeanstalkEnvironments(sess *session.Session) ([]Resource, error)
 
 		for _, environment := range output.Environments {
 			resources = append(resources, &ElasticBeanstalkEnvironment{
-				svc:  svc,
-				ID:   environment.EnvironmentId,
-				name: environment.EnvironmentName,
+				svc:             svc,
+				ID:              environment.EnvironmentId,
+				name:            environment.EnvironmentName,
+				applicationName: environment.ApplicationName,
+				status:          environment.Status,
 			})
 		}
 
@@ -51,6 +55,11 @@ func ListElasticBeanstalkEnvironments(sess *session.Session) ([]Resource, error)
 }
 
 func (f *ElasticBeanstalkEnvironment) Remove() error {
+	switch aws.StringValue(f.status) {
+	case elasticbeanstalk.EnvironmentStatusTerminating, elasticbeanstalk.EnvironmentStatusTerminated:
+		// The environment stays in the list until the termination is complete.
+		return nil
+	}
 
 	_, err := f.svc.TerminateEnvironment(&elasticbeanstalk.TerminateEnvironmentInput{
 		EnvironmentId:      f.ID,
@@ -63,7 +72,9 @@ func (f *ElasticBeanstalkEnvironment) Remove() error {
 
 func (e *ElasticBeanstalkEnvironment) Properties() types.Properties {
 	return types.NewProperties().
-		Set("Name", e.name)
+		Set("Name", e.name).
+		Set("ApplicationName", e.applicationName).
+		Set("Status", e.status)
 }
 
 func (f *ElasticBeanstalkEnvironment) String() string {
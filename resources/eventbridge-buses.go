@@ -0,0 +1,88 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+const EventBridgeDefaultBusName = "default"
+
+type EventBridgeBus struct {
+	svc  *eventbridge.EventBridge
+	name *string
+	arn  *string
+}
+
+func init() {
+	register("EventBridgeBus", ListEventBridgeBuses)
+}
+
+func ListEventBridgeBuses(sess *session.Session) ([]Resource, error) {
+	svc := eventbridge.New(sess)
+	resources := []Resource{}
+
+	buses, err := listEventBridgeBuses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bus := range buses {
+		if aws.StringValue(bus.Name) == EventBridgeDefaultBusName {
+			// The default bus cannot be deleted.
+			continue
+		}
+
+		resources = append(resources, &EventBridgeBus{
+			svc:  svc,
+			name: bus.Name,
+			arn:  bus.Arn,
+		})
+	}
+
+	return resources, nil
+}
+
+func listEventBridgeBuses(svc *eventbridge.EventBridge) ([]*eventbridge.EventBus, error) {
+	buses := []*eventbridge.EventBus{}
+
+	params := &eventbridge.ListEventBusesInput{
+		Limit: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListEventBuses(params)
+		if err != nil {
+			return nil, err
+		}
+
+		buses = append(buses, output.EventBuses...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return buses, nil
+}
+
+func (f *EventBridgeBus) Remove() error {
+	_, err := f.svc.DeleteEventBus(&eventbridge.DeleteEventBusInput{
+		Name: f.name,
+	})
+
+	return err
+}
+
+func (f *EventBridgeBus) String() string {
+	return *f.name
+}
+
+func (f *EventBridgeBus) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("ARN", f.arn)
+}
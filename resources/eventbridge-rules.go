@@ -0,0 +1,150 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+// EventBridgeRule covers the rules of custom event buses. The rules of the
+// default bus are handled by CloudWatchEventsRule.
+type EventBridgeRule struct {
+	svc     *eventbridge.EventBridge
+	name    *string
+	busName *string
+	state   *string
+}
+
+func init() {
+	register("EventBridgeRule", ListEventBridgeRules)
+}
+
+func ListEventBridgeRules(sess *session.Session) ([]Resource, error) {
+	svc := eventbridge.New(sess)
+	resources := []Resource{}
+
+	buses, err := listEventBridgeBuses(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, bus := range buses {
+		if aws.StringValue(bus.Name) == EventBridgeDefaultBusName {
+			continue
+		}
+
+		params := &eventbridge.ListRulesInput{
+			EventBusName: bus.Name,
+			Limit:        aws.Int64(100),
+		}
+
+		for {
+			output, err := svc.ListRules(params)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, rule := range output.Rules {
+				// Rules managed by other AWS services are removed
+				// together with the resources of these services.
+				if rule.ManagedBy != nil {
+					continue
+				}
+
+				resources = append(resources, &EventBridgeRule{
+					svc:     svc,
+					name:    rule.Name,
+					busName: bus.Name,
+					state:   rule.State,
+				})
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+
+			params.NextToken = output.NextToken
+		}
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the targets of the rule first, because AWS does not allow
+// to delete rules with targets.
+func (f *EventBridgeRule) Remove() error {
+	ids, err := f.targetIDs()
+	if err != nil {
+		return err
+	}
+
+	// RemoveTargets accepts at most 10 targets per call.
+	for len(ids) > 0 {
+		n := len(ids)
+		if n > 10 {
+			n = 10
+		}
+
+		_, err = f.svc.RemoveTargets(&eventbridge.RemoveTargetsInput{
+			Rule:         f.name,
+			EventBusName: f.busName,
+			Ids:          ids[:n],
+		})
+		if err != nil {
+			return err
+		}
+
+		ids = ids[n:]
+	}
+
+	_, err = f.svc.DeleteRule(&eventbridge.DeleteRuleInput{
+		Name:         f.name,
+		EventBusName: f.busName,
+	})
+
+	return err
+}
+
+// targetIDs lists all targets of the rule before any of them gets removed,
+// since removing targets while paging would shift the pages.
+func (f *EventBridgeRule) targetIDs() ([]*string, error) {
+	params := &eventbridge.ListTargetsByRuleInput{
+		Rule:         f.name,
+		EventBusName: f.busName,
+		Limit:        aws.Int64(100),
+	}
+	ids := []*string{}
+
+	for {
+		output, err := f.svc.ListTargetsByRule(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, target := range output.Targets {
+			ids = append(ids, target.Id)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return ids, nil
+}
+
+func (f *EventBridgeRule) String() string {
+	return fmt.Sprintf("%s -> %s", *f.busName, *f.name)
+}
+
+func (f *EventBridgeRule) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("EventBusName", f.busName).
+		Set("State", f.state)
+}
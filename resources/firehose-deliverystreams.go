@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/firehose"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type FirehoseDeliveryStream struct {
-	svc                *firehose.Firehose
-	deliveryStreamName *string
+	svc                  *firehose.Firehose
+	deliveryStreamName   *string
+	deliveryStreamStatus *string
+	createTimestamp      *time.Time
 }
 
 func init() {
@@ -31,9 +36,18 @@ func ListFirehoseDeliveryStreams(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, deliveryStreamName := range output.DeliveryStreamNames {
+			description, err := svc.DescribeDeliveryStream(&firehose.DescribeDeliveryStreamInput{
+				DeliveryStreamName: deliveryStreamName,
+			})
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &FirehoseDeliveryStream{
-				svc:                svc,
-				deliveryStreamName: deliveryStreamName,
+				svc:                  svc,
+				deliveryStreamName:   deliveryStreamName,
+				deliveryStreamStatus: description.DeliveryStreamDescription.DeliveryStreamStatus,
+				createTimestamp:      description.DeliveryStreamDescription.CreateTimestamp,
 			})
 			lastDeliveryStreamName = deliveryStreamName
 		}
@@ -49,6 +63,10 @@ func ListFirehoseDeliveryStreams(sess *session.Session) ([]Resource, error) {
 }
 
 func (f *FirehoseDeliveryStream) Remove() error {
+	if aws.StringValue(f.deliveryStreamStatus) == firehose.DeliveryStreamStatusDeleting {
+		// The stream stays in the list until the deletion is complete.
+		return nil
+	}
 
 	_, err := f.svc.DeleteDeliveryStream(&firehose.DeleteDeliveryStreamInput{
 		DeliveryStreamName: f.deliveryStreamName,
@@ -60,3 +78,10 @@ func (f *FirehoseDeliveryStream) Remove() error {
 func (f *FirehoseDeliveryStream) String() string {
 	return *f.deliveryStreamName
 }
+
+func (f *FirehoseDeliveryStream) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.deliveryStreamName).
+		Set("Status", f.deliveryStreamStatus).
+		Set("CreationTime", f.createTimestamp)
+}
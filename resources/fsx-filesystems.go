@@ -4,12 +4,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type FSxFileSystem struct {
 	svc        *fsx.FSx
 	filesystem *fsx.FileSystem
+
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -45,10 +48,34 @@ func ListFSxFileSystems(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+func (f *FSxFileSystem) FeatureFlags(ff config.FeatureFlags) {
+	f.featureFlags = ff
+}
+
 func (f *FSxFileSystem) Remove() error {
-	_, err := f.svc.DeleteFileSystem(&fsx.DeleteFileSystemInput{
+	if aws.StringValue(f.filesystem.Lifecycle) == fsx.FileSystemLifecycleDeleting {
+		// The file system stays in the list until the deletion is complete.
+		return nil
+	}
+
+	params := &fsx.DeleteFileSystemInput{
 		FileSystemId: f.filesystem.FileSystemId,
-	})
+	}
+
+	if f.featureFlags.SkipFSxFinalBackup {
+		switch aws.StringValue(f.filesystem.FileSystemType) {
+		case fsx.FileSystemTypeWindows:
+			params.WindowsConfiguration = &fsx.DeleteFileSystemWindowsConfiguration{
+				SkipFinalBackup: aws.Bool(true),
+			}
+		case fsx.FileSystemTypeLustre:
+			params.LustreConfiguration = &fsx.DeleteFileSystemLustreConfiguration{
+				SkipFinalBackup: aws.Bool(true),
+			}
+		}
+	}
+
+	_, err := f.svc.DeleteFileSystem(params)
 
 	return err
 }
@@ -58,7 +85,10 @@ func (f *FSxFileSystem) Properties() types.Properties {
 	for _, tagValue := range f.filesystem.Tags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
+	properties.Set("FileSystemID", f.filesystem.FileSystemId)
 	properties.Set("Type", f.filesystem.FileSystemType)
+	properties.Set("Lifecycle", f.filesystem.Lifecycle)
+	properties.Set("CreationTime", f.filesystem.CreationTime)
 	return properties
 }
 
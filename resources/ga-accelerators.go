@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/globalaccelerator"
@@ -9,8 +12,12 @@ import (
 
 // GlobalAccelerator model
 type GlobalAccelerator struct {
-	svc *globalaccelerator.GlobalAccelerator
-	ARN *string
+	svc         *globalaccelerator.GlobalAccelerator
+	ARN         *string
+	name        *string
+	status      *string
+	enabled     *bool
+	createdTime *time.Time
 }
 
 func init() {
@@ -34,8 +41,12 @@ func ListGlobalAccelerators(sess *session.Session) ([]Resource, error) {
 
 		for _, accelerator := range output.Accelerators {
 			resources = append(resources, &GlobalAccelerator{
-				svc: svc,
-				ARN: accelerator.AcceleratorArn,
+				svc:         svc,
+				ARN:         accelerator.AcceleratorArn,
+				name:        accelerator.Name,
+				status:      accelerator.Status,
+				enabled:     accelerator.Enabled,
+				createdTime: accelerator.CreatedTime,
 			})
 		}
 
@@ -49,9 +60,37 @@ func ListGlobalAccelerators(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
-// Remove resource
+// Remove resource. An accelerator has to be disabled and deployed before it
+// can be deleted. The deletion also fails as long as it has listeners, so
+// this returns an error until all steps are done and the item gets retried.
 func (ga *GlobalAccelerator) Remove() error {
-	_, err := ga.svc.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{
+	resp, err := ga.svc.DescribeAccelerator(&globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: ga.ARN,
+	})
+	if err != nil {
+		return err
+	}
+
+	ga.status = resp.Accelerator.Status
+	ga.enabled = resp.Accelerator.Enabled
+
+	if aws.BoolValue(ga.enabled) {
+		_, err := ga.svc.UpdateAccelerator(&globalaccelerator.UpdateAcceleratorInput{
+			AcceleratorArn: ga.ARN,
+			Enabled:        aws.Bool(false),
+		})
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("disabling accelerator before deletion")
+	}
+
+	if aws.StringValue(ga.status) == globalaccelerator.AcceleratorStatusInProgress {
+		return fmt.Errorf("waiting for accelerator to be deployed")
+	}
+
+	_, err = ga.svc.DeleteAccelerator(&globalaccelerator.DeleteAcceleratorInput{
 		AcceleratorArn: ga.ARN,
 	})
 
@@ -62,6 +101,12 @@ func (ga *GlobalAccelerator) Remove() error {
 func (ga *GlobalAccelerator) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("ARN", ga.ARN)
+	properties.Set("Name", ga.name)
+	properties.Set("Status", ga.status)
+	properties.Set("Enabled", ga.enabled)
+	if ga.createdTime != nil {
+		properties.Set("CreatedTime", ga.createdTime.Format(time.RFC3339))
+	}
 	return properties
 }
 
@@ -9,8 +9,10 @@ import (
 
 // GlobalAcceleratorEndpointGroup model
 type GlobalAcceleratorEndpointGroup struct {
-	svc *globalaccelerator.GlobalAccelerator
-	ARN *string
+	svc         *globalaccelerator.GlobalAccelerator
+	ARN         *string
+	listenerARN *string
+	region      *string
 }
 
 func init() {
@@ -86,8 +88,10 @@ func ListGlobalAcceleratorEndpointGroups(sess *session.Session) ([]Resource, err
 
 			for _, endpointGroup := range output.EndpointGroups {
 				resources = append(resources, &GlobalAcceleratorEndpointGroup{
-					svc: svc,
-					ARN: endpointGroup.EndpointGroupArn,
+					svc:         svc,
+					ARN:         endpointGroup.EndpointGroupArn,
+					listenerARN: listenerArn,
+					region:      endpointGroup.EndpointGroupRegion,
 				})
 			}
 
@@ -115,6 +119,8 @@ func (gaeg *GlobalAcceleratorEndpointGroup) Remove() error {
 func (gaeg *GlobalAcceleratorEndpointGroup) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("ARN", gaeg.ARN)
+	properties.Set("ListenerARN", gaeg.listenerARN)
+	properties.Set("Region", gaeg.region)
 	return properties
 }
 
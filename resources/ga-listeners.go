@@ -9,8 +9,10 @@ import (
 
 // GlobalAcceleratorListener model
 type GlobalAcceleratorListener struct {
-	svc *globalaccelerator.GlobalAccelerator
-	ARN *string
+	svc            *globalaccelerator.GlobalAccelerator
+	ARN            *string
+	acceleratorARN *string
+	protocol       *string
 }
 
 func init() {
@@ -60,8 +62,10 @@ func ListGlobalAcceleratorListeners(sess *session.Session) ([]Resource, error) {
 
 			for _, listener := range output.Listeners {
 				resources = append(resources, &GlobalAcceleratorListener{
-					svc: svc,
-					ARN: listener.ListenerArn,
+					svc:            svc,
+					ARN:            listener.ListenerArn,
+					acceleratorARN: acceleratorARN,
+					protocol:       listener.Protocol,
 				})
 			}
 
@@ -76,7 +80,8 @@ func ListGlobalAcceleratorListeners(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
-// Remove resource
+// Remove resource. The deletion fails as long as the listener has endpoint
+// groups and gets retried.
 func (gal *GlobalAcceleratorListener) Remove() error {
 	_, err := gal.svc.DeleteListener(&globalaccelerator.DeleteListenerInput{
 		ListenerArn: gal.ARN,
@@ -89,6 +94,8 @@ func (gal *GlobalAcceleratorListener) Remove() error {
 func (gal *GlobalAcceleratorListener) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("ARN", gal.ARN)
+	properties.Set("AcceleratorARN", gal.acceleratorARN)
+	properties.Set("Protocol", gal.protocol)
 	return properties
 }
 
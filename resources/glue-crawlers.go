@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type GlueCrawler struct {
-	svc  *glue.Glue
-	name *string
+	svc          *glue.Glue
+	name         *string
+	databaseName *string
+	creationTime *time.Time
 }
 
 func init() {
@@ -31,8 +36,10 @@ func ListGlueCrawlers(sess *session.Session) ([]Resource, error) {
 
 		for _, crawler := range output.Crawlers {
 			resources = append(resources, &GlueCrawler{
-				svc:  svc,
-				name: crawler.Name,
+				svc:          svc,
+				name:         crawler.Name,
+				databaseName: crawler.DatabaseName,
+				creationTime: crawler.CreationTime,
 			})
 		}
 
@@ -58,3 +65,10 @@ func (f *GlueCrawler) Remove() error {
 func (f *GlueCrawler) String() string {
 	return *f.name
 }
+
+func (f *GlueCrawler) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("DatabaseName", f.databaseName).
+		Set("CreationTime", f.creationTime)
+}
@@ -1,14 +1,18 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type GlueDatabase struct {
-	svc  *glue.Glue
-	name *string
+	svc        *glue.Glue
+	name       *string
+	createTime *time.Time
 }
 
 func init() {
@@ -31,8 +35,9 @@ func ListGlueDatabases(sess *session.Session) ([]Resource, error) {
 
 		for _, database := range output.DatabaseList {
 			resources = append(resources, &GlueDatabase{
-				svc:  svc,
-				name: database.Name,
+				svc:        svc,
+				name:       database.Name,
+				createTime: database.CreateTime,
 			})
 		}
 
@@ -58,3 +63,9 @@ func (f *GlueDatabase) Remove() error {
 func (f *GlueDatabase) String() string {
 	return *f.name
 }
+
+func (f *GlueDatabase) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("CreateTime", f.createTime)
+}
@@ -1,14 +1,18 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type GlueJob struct {
-	svc     *glue.Glue
-	jobName *string
+	svc       *glue.Glue
+	jobName   *string
+	createdOn *time.Time
 }
 
 func init() {
@@ -31,8 +35,9 @@ func ListGlueJobs(sess *session.Session) ([]Resource, error) {
 
 		for _, job := range output.Jobs {
 			resources = append(resources, &GlueJob{
-				svc:     svc,
-				jobName: job.Name,
+				svc:       svc,
+				jobName:   job.Name,
+				createdOn: job.CreatedOn,
 			})
 		}
 
@@ -58,3 +63,9 @@ func (f *GlueJob) Remove() error {
 func (f *GlueJob) String() string {
 	return *f.jobName
 }
+
+func (f *GlueJob) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.jobName).
+		Set("CreatedOn", f.createdOn)
+}
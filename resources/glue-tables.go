@@ -0,0 +1,97 @@
+package resources
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+// GlueTable allows to remove tables without removing their database. Removing
+// a GlueDatabase deletes its tables as well, therefore it is sufficient to
+// target GlueDatabase, if the whole database should be removed.
+type GlueTable struct {
+	svc          *glue.Glue
+	name         *string
+	databaseName *string
+	tableType    *string
+	createTime   *time.Time
+}
+
+func init() {
+	register("GlueTable", ListGlueTables)
+}
+
+func ListGlueTables(sess *session.Session) ([]Resource, error) {
+	svc := glue.New(sess)
+	resources := []Resource{}
+
+	databases := []*string{}
+	err := svc.GetDatabasesPages(&glue.GetDatabasesInput{
+		MaxResults: aws.Int64(100),
+	}, func(page *glue.GetDatabasesOutput, lastPage bool) bool {
+		for _, database := range page.DatabaseList {
+			databases = append(databases, database.Name)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, databaseName := range databases {
+		params := &glue.GetTablesInput{
+			DatabaseName: databaseName,
+			MaxResults:   aws.Int64(100),
+		}
+
+		for {
+			output, err := svc.GetTables(params)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, table := range output.TableList {
+				resources = append(resources, &GlueTable{
+					svc:          svc,
+					name:         table.Name,
+					databaseName: databaseName,
+					tableType:    table.TableType,
+					createTime:   table.CreateTime,
+				})
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+
+			params.NextToken = output.NextToken
+		}
+	}
+
+	return resources, nil
+}
+
+func (f *GlueTable) Remove() error {
+	_, err := f.svc.DeleteTable(&glue.DeleteTableInput{
+		DatabaseName: f.databaseName,
+		Name:         f.name,
+	})
+
+	return err
+}
+
+func (f *GlueTable) String() string {
+	return fmt.Sprintf("%s -> %s", *f.databaseName, *f.name)
+}
+
+func (f *GlueTable) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("DatabaseName", f.databaseName).
+		Set("TableType", f.tableType).
+		Set("CreateTime", f.createTime)
+}
@@ -4,11 +4,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/glue"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type GlueTrigger struct {
-	svc  *glue.Glue
-	name *string
+	svc         *glue.Glue
+	name        *string
+	triggerType *string
+	state       *string
 }
 
 func init() {
@@ -31,8 +34,10 @@ func ListGlueTriggers(sess *session.Session) ([]Resource, error) {
 
 		for _, trigger := range output.Triggers {
 			resources = append(resources, &GlueTrigger{
-				svc:  svc,
-				name: trigger.Name,
+				svc:         svc,
+				name:        trigger.Name,
+				triggerType: trigger.Type,
+				state:       trigger.State,
 			})
 		}
 
@@ -58,3 +63,10 @@ func (f *GlueTrigger) Remove() error {
 func (f *GlueTrigger) String() string {
 	return *f.name
 }
+
+func (f *GlueTrigger) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("Type", f.triggerType).
+		Set("State", f.state)
+}
@@ -0,0 +1,104 @@
+package resources
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/guardduty"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type GuardDutyDetector struct {
+	svc    *guardduty.GuardDuty
+	id     *string
+	status *string
+	tags   map[string]*string
+}
+
+func init() {
+	register("GuardDutyDetector", ListGuardDutyDetectors)
+}
+
+// ListGuardDutyDetectors returns at most one detector, since there is only one
+// detector per region.
+func ListGuardDutyDetectors(sess *session.Session) ([]Resource, error) {
+	svc := guardduty.New(sess)
+	resources := []Resource{}
+
+	params := &guardduty.ListDetectorsInput{
+		MaxResults: aws.Int64(50),
+	}
+
+	for {
+		output, err := svc.ListDetectors(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, id := range output.DetectorIds {
+			detector, err := svc.GetDetector(&guardduty.GetDetectorInput{
+				DetectorId: id,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, &GuardDutyDetector{
+				svc:    svc,
+				id:     id,
+				status: detector.Status,
+				tags:   detector.Tags,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Filter protects detectors of administrator accounts, because deleting them
+// would also stop GuardDuty for all member accounts of the organization.
+func (f *GuardDutyDetector) Filter() error {
+	output, err := f.svc.ListMembers(&guardduty.ListMembersInput{
+		DetectorId: f.id,
+		MaxResults: aws.Int64(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(output.Members) > 0 {
+		return fmt.Errorf("detector of an administrator account with members")
+	}
+
+	return nil
+}
+
+func (f *GuardDutyDetector) Remove() error {
+	_, err := f.svc.DeleteDetector(&guardduty.DeleteDetectorInput{
+		DetectorId: f.id,
+	})
+
+	return err
+}
+
+func (f *GuardDutyDetector) String() string {
+	return *f.id
+}
+
+func (f *GuardDutyDetector) Properties() types.Properties {
+	properties := types.NewProperties()
+	for key, value := range f.tags {
+		properties.SetTag(aws.String(key), value)
+	}
+	properties.
+		Set("DetectorID", f.id).
+		Set("Status", f.status)
+	return properties
+}
@@ -14,7 +14,7 @@ type IAMGroupPolicy struct {
 }
 
 func init() {
-	register("IAMGroupPolicy", ListIAMGroupPolicies)
+	registerGlobal("IAMGroupPolicy", ListIAMGroupPolicies)
 }
 
 func ListIAMGroupPolicies(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,7 @@ type IAMGroupPolicyAttachment struct {
 }
 
 func init() {
-	register("IAMGroupPolicyAttachment", ListIAMGroupPolicyAttachments)
+	registerGlobal("IAMGroupPolicyAttachment", ListIAMGroupPolicyAttachments)
 }
 
 func ListIAMGroupPolicyAttachments(sess *session.Session) ([]Resource, error) {
@@ -11,7 +11,7 @@ type IAMGroup struct {
 }
 
 func init() {
-	register("IAMGroup", ListIAMGroups)
+	registerGlobal("IAMGroup", ListIAMGroups)
 }
 
 func ListIAMGroups(sess *session.Session) ([]Resource, error) {
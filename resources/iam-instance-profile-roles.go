@@ -14,7 +14,7 @@ type IAMInstanceProfileRole struct {
 }
 
 func init() {
-	register("IAMInstanceProfileRole", ListIAMInstanceProfileRoles)
+	registerGlobal("IAMInstanceProfileRole", ListIAMInstanceProfileRoles)
 }
 
 func ListIAMInstanceProfileRoles(sess *session.Session) ([]Resource, error) {
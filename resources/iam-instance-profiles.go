@@ -11,7 +11,7 @@ type IAMInstanceProfile struct {
 }
 
 func init() {
-	register("IAMInstanceProfile", ListIAMInstanceProfiles)
+	registerGlobal("IAMInstanceProfile", ListIAMInstanceProfiles)
 }
 
 func ListIAMInstanceProfiles(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,7 @@ type IAMUserGroupAttachment struct {
 }
 
 func init() {
-	register("IAMUserGroupAttachment", ListIAMUserGroupAttachments)
+	registerGlobal("IAMUserGroupAttachment", ListIAMUserGroupAttachments)
 }
 
 func ListIAMUserGroupAttachments(sess *session.Session) ([]Resource, error) {
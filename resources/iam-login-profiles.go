@@ -14,7 +14,7 @@ type IAMLoginProfile struct {
 }
 
 func init() {
-	register("IAMLoginProfile", ListIAMLoginProfiles)
+	registerGlobal("IAMLoginProfile", ListIAMLoginProfiles)
 }
 
 func ListIAMLoginProfiles(sess *session.Session) ([]Resource, error) {
@@ -11,7 +11,7 @@ type IAMOpenIDConnectProvider struct {
 }
 
 func init() {
-	register("IAMOpenIDConnectProvider", ListIAMOpenIDConnectProvider)
+	registerGlobal("IAMOpenIDConnectProvider", ListIAMOpenIDConnectProvider)
 }
 
 func ListIAMOpenIDConnectProvider(sess *session.Session) ([]Resource, error) {
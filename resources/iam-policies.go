@@ -16,7 +16,7 @@ type IAMPolicy struct {
 }
 
 func init() {
-	register("IAMPolicy", ListIAMPolicies)
+	registerGlobal("IAMPolicy", ListIAMPolicies)
 }
 
 func ListIAMPolicies(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,7 @@ type IAMRolePolicyAttachment struct {
 }
 
 func init() {
-	register("IAMRolePolicyAttachment", ListIAMRolePolicyAttachments)
+	registerGlobal("IAMRolePolicyAttachment", ListIAMRolePolicyAttachments)
 }
 
 func ListIAMRolePolicyAttachments(sess *session.Session) ([]Resource, error) {
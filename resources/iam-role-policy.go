@@ -18,7 +18,7 @@ type IAMRolePolicy struct {
 }
 
 func init() {
-	register("IAMRolePolicy", ListIAMRolePolicies)
+	registerGlobal("IAMRolePolicy", ListIAMRolePolicies)
 }
 
 func ListIAMRolePolicies(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,7 @@ type IAMRole struct {
 }
 
 func init() {
-	register("IAMRole", ListIAMRoles)
+	registerGlobal("IAMRole", ListIAMRoles)
 }
 
 func ListIAMRoles(sess *session.Session) ([]Resource, error) {
@@ -88,7 +88,8 @@ func (role *IAMRole) Properties() types.Properties {
 	}
 	properties.
 		Set("Name", role.name).
-		Set("Path", role.path)
+		Set("Path", role.path).
+		Set("CreateDate", role.role.CreateDate)
 	return properties
 }
 
@@ -11,7 +11,7 @@ type IAMSAMLProvider struct {
 }
 
 func init() {
-	register("IAMSAMLProvider", ListIAMSAMLProvider)
+	registerGlobal("IAMSAMLProvider", ListIAMSAMLProvider)
 }
 
 func ListIAMSAMLProvider(sess *session.Session) ([]Resource, error) {
@@ -11,7 +11,7 @@ type IAMServerCertificate struct {
 }
 
 func init() {
-	register("IAMServerCertificate", ListIAMServerCertificates)
+	registerGlobal("IAMServerCertificate", ListIAMServerCertificates)
 }
 
 func ListIAMServerCertificates(sess *session.Session) ([]Resource, error) {
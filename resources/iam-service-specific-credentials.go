@@ -16,7 +16,7 @@ type IAMServiceSpecificCredential struct {
 }
 
 func init() {
-	register("IAMServiceSpecificCredential", ListServiceSpecificCredentials)
+	registerGlobal("IAMServiceSpecificCredential", ListServiceSpecificCredentials)
 }
 
 func ListServiceSpecificCredentials(sess *session.Session) ([]Resource, error) {
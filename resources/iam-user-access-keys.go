@@ -16,7 +16,7 @@ type IAMUserAccessKey struct {
 }
 
 func init() {
-	register("IAMUserAccessKey", ListIAMUserAccessKeys)
+	registerGlobal("IAMUserAccessKey", ListIAMUserAccessKeys)
 }
 
 func ListIAMUserAccessKeys(sess *session.Session) ([]Resource, error) {
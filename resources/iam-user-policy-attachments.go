@@ -16,7 +16,7 @@ type IAMUserPolicyAttachment struct {
 }
 
 func init() {
-	register("IAMUserPolicyAttachment", ListIAMUserPolicyAttachments)
+	registerGlobal("IAMUserPolicyAttachment", ListIAMUserPolicyAttachments)
 }
 
 func ListIAMUserPolicyAttachments(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,7 @@ type IAMUserPolicy struct {
 }
 
 func init() {
-	register("IAMUserPolicy", ListIAMUserPolicies)
+	registerGlobal("IAMUserPolicy", ListIAMUserPolicies)
 }
 
 func ListIAMUserPolicies(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,7 @@ type UserSSHKey struct {
 }
 
 func init() {
-	register("IAMUserSSHPublicKey", ListIAMUserSSHPublicKeys)
+	registerGlobal("IAMUserSSHPublicKey", ListIAMUserSSHPublicKeys)
 }
 
 func ListIAMUserSSHPublicKeys(sess *session.Session) ([]Resource, error) {
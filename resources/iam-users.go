@@ -11,7 +11,7 @@ type IAMUser struct {
 }
 
 func init() {
-	register("IAMUser", ListIAMUsers)
+	registerGlobal("IAMUser", ListIAMUsers)
 }
 
 func ListIAMUsers(sess *session.Session) ([]Resource, error) {
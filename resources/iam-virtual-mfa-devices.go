@@ -15,7 +15,7 @@ type IAMVirtualMFADevice struct {
 }
 
 func init() {
-	register("IAMVirtualMFADevice", ListIAMVirtualMFADevices)
+	registerGlobal("IAMVirtualMFADevice", ListIAMVirtualMFADevices)
 }
 
 func ListIAMVirtualMFADevices(sess *session.Session) ([]Resource, error) {
@@ -26,6 +26,10 @@ type LegacyStringer interface {
 	String() string
 }
 
+// ResourcePropertyGetter is implemented by resources that support filtering
+// by properties. Resources expose their own tags with SetTag as "tag:<key>",
+// so tag filters work the same for all resource types. Tags of related
+// resources, like the VPC of an attachment, use SetTagWithPrefix instead.
 type ResourcePropertyGetter interface {
 	Resource
 	Properties() types.Properties
@@ -36,7 +40,35 @@ type FeatureFlagGetter interface {
 	FeatureFlags(config.FeatureFlags)
 }
 
-var resourceListers = make(ResourceListers)
+// DeletionScheduler is implemented by resources, which do not get deleted
+// immediately, but are only scheduled for deletion, like KMS keys. The
+// returned description gets reported instead of "removed".
+type DeletionScheduler interface {
+	Resource
+	DeletionSchedule() string
+}
+
+// RemovalChecker is implemented by resources, whose removal can fail after AWS
+// accepted it, like CloudFormation stacks ending up in DELETE_FAILED. Items,
+// whose resource returns an error while waiting, are marked as failed and get
+// retried.
+type RemovalChecker interface {
+	Resource
+	RemovalError() error
+}
+
+// CostEstimator is implemented by resources, whose monthly cost can be
+// estimated from a built-in price table. It returns false, if there is no
+// price for the resource.
+type CostEstimator interface {
+	Resource
+	EstimatedMonthlyCost() (float64, bool)
+}
+
+var (
+	resourceListers     = make(ResourceListers)
+	globalResourceTypes = make(map[string]bool)
+)
 
 func register(name string, lister ResourceLister) {
 	_, exists := resourceListers[name]
@@ -47,6 +79,18 @@ func register(name string, lister ResourceLister) {
 	resourceListers[name] = lister
 }
 
+// registerGlobal registers a lister of a global service, like IAM. Its
+// resources only get listed in the global region.
+func registerGlobal(name string, lister ResourceLister) {
+	register(name, lister)
+	globalResourceTypes[name] = true
+}
+
+// IsGlobal returns true, if the resource type belongs to a global service.
+func IsGlobal(name string) bool {
+	return globalResourceTypes[name]
+}
+
 func GetListers() ResourceListers {
 	return resourceListers
 }
@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kinesis"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type KinesisStream struct {
-	svc        *kinesis.Kinesis
-	streamName *string
+	svc                     *kinesis.Kinesis
+	streamName              *string
+	streamStatus            *string
+	streamCreationTimestamp *time.Time
 }
 
 func init() {
@@ -30,9 +35,18 @@ func ListKinesisStreams(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, streamName := range output.StreamNames {
+			summary, err := svc.DescribeStreamSummary(&kinesis.DescribeStreamSummaryInput{
+				StreamName: streamName,
+			})
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &KinesisStream{
-				svc:        svc,
-				streamName: streamName,
+				svc:                     svc,
+				streamName:              streamName,
+				streamStatus:            summary.StreamDescriptionSummary.StreamStatus,
+				streamCreationTimestamp: summary.StreamDescriptionSummary.StreamCreationTimestamp,
 			})
 			lastStreamName = streamName
 		}
@@ -48,9 +62,14 @@ func ListKinesisStreams(sess *session.Session) ([]Resource, error) {
 }
 
 func (f *KinesisStream) Remove() error {
+	if aws.StringValue(f.streamStatus) == kinesis.StreamStatusDeleting {
+		// The stream stays in the list until the deletion is complete.
+		return nil
+	}
 
 	_, err := f.svc.DeleteStream(&kinesis.DeleteStreamInput{
-		StreamName: f.streamName,
+		StreamName:              f.streamName,
+		EnforceConsumerDeletion: aws.Bool(true),
 	})
 
 	return err
@@ -59,3 +78,10 @@ func (f *KinesisStream) Remove() error {
 func (f *KinesisStream) String() string {
 	return *f.streamName
 }
+
+func (f *KinesisStream) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.streamName).
+		Set("Status", f.streamStatus).
+		Set("CreationTime", f.streamCreationTimestamp)
+}
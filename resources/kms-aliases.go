@@ -10,8 +10,9 @@ import (
 )
 
 type KMSAlias struct {
-	svc  *kms.KMS
-	name string
+	svc         *kms.KMS
+	name        string
+	targetKeyID *string
 }
 
 func init() {
@@ -21,19 +22,21 @@ func init() {
 func ListKMSAliases(sess *session.Session) ([]Resource, error) {
 	svc := kms.New(sess)
 
-	resp, err := svc.ListAliases(nil)
+	resources := make([]Resource, 0)
+	err := svc.ListAliasesPages(nil, func(resp *kms.ListAliasesOutput, lastPage bool) bool {
+		for _, alias := range resp.Aliases {
+			resources = append(resources, &KMSAlias{
+				svc:         svc,
+				name:        *alias.AliasName,
+				targetKeyID: alias.TargetKeyId,
+			})
+		}
+		return true
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resources := make([]Resource, 0)
-	for _, alias := range resp.Aliases {
-		resources = append(resources, &KMSAlias{
-			svc:  svc,
-			name: *alias.AliasName,
-		})
-	}
-
 	return resources, nil
 }
 
@@ -58,7 +61,8 @@ func (e *KMSAlias) String() string {
 func (e *KMSAlias) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.
-		Set("Name", e.name)
+		Set("Name", e.name).
+		Set("TargetKeyID", e.targetKeyID)
 
 	return properties
 }
@@ -6,15 +6,23 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
+// defaultKMSPendingWindowInDays is the shortest waiting period AWS allows
+// before a scheduled key gets deleted.
+const defaultKMSPendingWindowInDays = 7
+
 type KMSKey struct {
-	svc     *kms.KMS
-	id      string
-	state   string
-	manager *string
-	tags    []*kms.Tag
+	svc         *kms.KMS
+	id          string
+	state       string
+	manager     *string
+	description *string
+	tags        []*kms.Tag
+
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -36,30 +44,27 @@ func ListKMSKeys(sess *session.Session) ([]Resource, error) {
 				return false
 			}
 
-			if *resp.KeyMetadata.KeyManager == kms.KeyManagerTypeAws {
-				continue
-			}
-
-			if *resp.KeyMetadata.KeyState == kms.KeyStatePendingDeletion {
-				continue
-			}
-
 			kmsKey := &KMSKey{
-				svc:     svc,
-				id:      *resp.KeyMetadata.KeyId,
-				state:   *resp.KeyMetadata.KeyState,
-				manager: resp.KeyMetadata.KeyManager,
+				svc:         svc,
+				id:          *resp.KeyMetadata.KeyId,
+				state:       *resp.KeyMetadata.KeyState,
+				manager:     resp.KeyMetadata.KeyManager,
+				description: resp.KeyMetadata.Description,
 			}
 
-			tags, err := svc.ListResourceTags(&kms.ListResourceTagsInput{
-				KeyId: key.KeyId,
-			})
-			if err != nil {
-				innerErr = err
-				return false
+			// AWS managed keys get filtered anyway, so their tags are not
+			// needed.
+			if aws.StringValue(kmsKey.manager) != kms.KeyManagerTypeAws {
+				tags, err := svc.ListResourceTags(&kms.ListResourceTagsInput{
+					KeyId: key.KeyId,
+				})
+				if err != nil {
+					innerErr = err
+					return false
+				}
+				kmsKey.tags = tags.Tags
 			}
 
-			kmsKey.tags = tags.Tags
 			resources = append(resources, kmsKey)
 		}
 
@@ -75,14 +80,18 @@ func ListKMSKeys(sess *session.Session) ([]Resource, error) {
 	}
 
 	if innerErr != nil {
-		return nil, err
+		return nil, innerErr
 	}
 
 	return resources, nil
 }
 
+func (e *KMSKey) FeatureFlags(ff config.FeatureFlags) {
+	e.featureFlags = ff
+}
+
 func (e *KMSKey) Filter() error {
-	if e.state == "PendingDeletion" {
+	if e.state == kms.KeyStatePendingDeletion {
 		return fmt.Errorf("is already in PendingDeletion state")
 	}
 
@@ -96,11 +105,24 @@ func (e *KMSKey) Filter() error {
 func (e *KMSKey) Remove() error {
 	_, err := e.svc.ScheduleKeyDeletion(&kms.ScheduleKeyDeletionInput{
 		KeyId:               &e.id,
-		PendingWindowInDays: aws.Int64(7),
+		PendingWindowInDays: aws.Int64(int64(e.pendingWindowInDays())),
 	})
 	return err
 }
 
+// DeletionSchedule reports, that the key is only scheduled for deletion and
+// still exists after the run.
+func (e *KMSKey) DeletionSchedule() string {
+	return fmt.Sprintf("scheduled for deletion in %d days", e.pendingWindowInDays())
+}
+
+func (e *KMSKey) pendingWindowInDays() int {
+	if e.featureFlags.KMSPendingWindowInDays > 0 {
+		return e.featureFlags.KMSPendingWindowInDays
+	}
+	return defaultKMSPendingWindowInDays
+}
+
 func (e *KMSKey) String() string {
 	return e.id
 }
@@ -108,7 +130,10 @@ func (e *KMSKey) String() string {
 func (i *KMSKey) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.
-		Set("ID", i.id)
+		Set("ID", i.id).
+		Set("Description", i.description).
+		Set("KeyManager", i.manager).
+		Set("KeyState", i.state)
 
 	for _, tag := range i.tags {
 		properties.SetTag(tag.TagKey, tag.TagValue)
@@ -0,0 +1,38 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKMSKey_Filter(t *testing.T) {
+	a := assert.New(t)
+
+	key := KMSKey{
+		id:      "1234",
+		state:   kms.KeyStateEnabled,
+		manager: aws.String(kms.KeyManagerTypeCustomer),
+	}
+	a.Nil(key.Filter())
+
+	key.state = kms.KeyStatePendingDeletion
+	a.Error(key.Filter())
+
+	key.state = kms.KeyStateEnabled
+	key.manager = aws.String(kms.KeyManagerTypeAws)
+	a.Error(key.Filter())
+}
+
+func TestKMSKey_DeletionSchedule(t *testing.T) {
+	a := assert.New(t)
+
+	key := KMSKey{id: "1234"}
+	a.Equal("scheduled for deletion in 7 days", key.DeletionSchedule())
+
+	key.FeatureFlags(config.FeatureFlags{KMSPendingWindowInDays: 30})
+	a.Equal("scheduled for deletion in 30 days", key.DeletionSchedule())
+}
@@ -0,0 +1,120 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type LicenseManagerLicenseConfiguration struct {
+	svc          *licensemanager.LicenseManager
+	arn          *string
+	name         *string
+	status       *string
+	licenseCount *int64
+	tags         []*licensemanager.Tag
+}
+
+func init() {
+	register("LicenseManagerLicenseConfiguration", ListLicenseManagerLicenseConfigurations)
+}
+
+func ListLicenseManagerLicenseConfigurations(sess *session.Session) ([]Resource, error) {
+	svc := licensemanager.New(sess)
+	resources := []Resource{}
+
+	params := &licensemanager.ListLicenseConfigurationsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListLicenseConfigurations(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, configuration := range output.LicenseConfigurations {
+			tags, err := svc.ListTagsForResource(&licensemanager.ListTagsForResourceInput{
+				ResourceArn: configuration.LicenseConfigurationArn,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, &LicenseManagerLicenseConfiguration{
+				svc:          svc,
+				arn:          configuration.LicenseConfigurationArn,
+				name:         configuration.Name,
+				status:       configuration.Status,
+				licenseCount: configuration.LicenseCount,
+				tags:         tags.Tags,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove disassociates all resources from the license configuration before
+// deleting it.
+func (f *LicenseManagerLicenseConfiguration) Remove() error {
+	params := &licensemanager.ListAssociationsForLicenseConfigurationInput{
+		LicenseConfigurationArn: f.arn,
+		MaxResults:              aws.Int64(100),
+	}
+
+	for {
+		output, err := f.svc.ListAssociationsForLicenseConfiguration(params)
+		if err != nil {
+			return err
+		}
+
+		for _, association := range output.LicenseConfigurationAssociations {
+			_, err := f.svc.UpdateLicenseSpecificationsForResource(&licensemanager.UpdateLicenseSpecificationsForResourceInput{
+				ResourceArn: association.ResourceArn,
+				RemoveLicenseSpecifications: []*licensemanager.LicenseSpecification{
+					{LicenseConfigurationArn: f.arn},
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	_, err := f.svc.DeleteLicenseConfiguration(&licensemanager.DeleteLicenseConfigurationInput{
+		LicenseConfigurationArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *LicenseManagerLicenseConfiguration) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.name).
+		Set("ARN", f.arn).
+		Set("Status", f.status).
+		Set("LicenseCount", f.licenseCount)
+	return properties
+}
+
+func (f *LicenseManagerLicenseConfiguration) String() string {
+	return *f.name
+}
@@ -0,0 +1,87 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type LightsailDatabase struct {
+	svc          *lightsail.Lightsail
+	databaseName *string
+	engine       *string
+	state        *string
+	createdAt    *time.Time
+	location     *lightsail.ResourceLocation
+	tags         []*lightsail.Tag
+}
+
+func init() {
+	register("LightsailDatabase", ListLightsailDatabases)
+}
+
+func ListLightsailDatabases(sess *session.Session) ([]Resource, error) {
+	svc := lightsail.New(sess)
+	resources := []Resource{}
+
+	params := &lightsail.GetRelationalDatabasesInput{}
+
+	for {
+		output, err := svc.GetRelationalDatabases(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, database := range output.RelationalDatabases {
+			resources = append(resources, &LightsailDatabase{
+				svc:          svc,
+				databaseName: database.Name,
+				engine:       database.Engine,
+				state:        database.State,
+				createdAt:    database.CreatedAt,
+				location:     database.Location,
+				tags:         database.Tags,
+			})
+		}
+
+		if output.NextPageToken == nil {
+			break
+		}
+
+		params.PageToken = output.NextPageToken
+	}
+
+	return resources, nil
+}
+
+func (f *LightsailDatabase) Remove() error {
+	_, err := f.svc.DeleteRelationalDatabase(&lightsail.DeleteRelationalDatabaseInput{
+		RelationalDatabaseName: f.databaseName,
+		SkipFinalSnapshot:      aws.Bool(true),
+	})
+
+	return err
+}
+
+func (f *LightsailDatabase) String() string {
+	return *f.databaseName
+}
+
+func (f *LightsailDatabase) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.databaseName).
+		Set("Engine", f.engine).
+		Set("State", f.state).
+		Set("CreatedAt", f.createdAt)
+	if f.location != nil {
+		properties.Set("AvailabilityZone", f.location.AvailabilityZone)
+	}
+	return properties
+}
@@ -1,13 +1,21 @@
 package resources
 
 import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type LightsailDisk struct {
-	svc      *lightsail.Lightsail
-	diskName *string
+	svc        *lightsail.Lightsail
+	diskName   *string
+	attachedTo *string
+	createdAt  *time.Time
+	location   *lightsail.ResourceLocation
+	tags       []*lightsail.Tag
 }
 
 func init() {
@@ -28,8 +36,12 @@ func ListLightsailDisks(sess *session.Session) ([]Resource, error) {
 
 		for _, disk := range output.Disks {
 			resources = append(resources, &LightsailDisk{
-				svc:      svc,
-				diskName: disk.Name,
+				svc:        svc,
+				diskName:   disk.Name,
+				attachedTo: disk.AttachedTo,
+				createdAt:  disk.CreatedAt,
+				location:   disk.Location,
+				tags:       disk.Tags,
 			})
 		}
 
@@ -43,7 +55,17 @@ func ListLightsailDisks(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove detaches the disk first, if it is attached to an instance. The
+// deletion fails until the detachment is complete and gets retried.
 func (f *LightsailDisk) Remove() error {
+	if aws.StringValue(f.attachedTo) != "" {
+		_, err := f.svc.DetachDisk(&lightsail.DetachDiskInput{
+			DiskName: f.diskName,
+		})
+		if err != nil && !IsAWSError(err, lightsail.ErrCodeInvalidInputException) {
+			return err
+		}
+	}
 
 	_, err := f.svc.DeleteDisk(&lightsail.DeleteDiskInput{
 		DiskName: f.diskName,
@@ -55,3 +77,18 @@ func (f *LightsailDisk) Remove() error {
 func (f *LightsailDisk) String() string {
 	return *f.diskName
 }
+
+func (f *LightsailDisk) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.diskName).
+		Set("AttachedTo", f.attachedTo).
+		Set("CreatedAt", f.createdAt)
+	if f.location != nil {
+		properties.Set("AvailabilityZone", f.location.AvailabilityZone)
+	}
+	return properties
+}
@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lightsail"
@@ -11,6 +13,8 @@ import (
 type LightsailInstance struct {
 	svc          *lightsail.Lightsail
 	instanceName *string
+	createdAt    *time.Time
+	location     *lightsail.ResourceLocation
 	tags         []*lightsail.Tag
 
 	featureFlags config.FeatureFlags
@@ -36,6 +40,8 @@ func ListLightsailInstances(sess *session.Session) ([]Resource, error) {
 			resources = append(resources, &LightsailInstance{
 				svc:          svc,
 				instanceName: instance.Name,
+				createdAt:    instance.CreatedAt,
+				location:     instance.Location,
 				tags:         instance.Tags,
 			})
 		}
@@ -70,6 +76,10 @@ func (f *LightsailInstance) Properties() types.Properties {
 		properties.SetTag(tag.Key, tag.Value)
 	}
 	properties.Set("Name", f.instanceName)
+	properties.Set("CreatedAt", f.createdAt)
+	if f.location != nil {
+		properties.Set("AvailabilityZone", f.location.AvailabilityZone)
+	}
 	return properties
 }
 
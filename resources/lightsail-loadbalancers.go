@@ -1,13 +1,20 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type LightsailLoadBalancer struct {
 	svc              *lightsail.Lightsail
 	loadBalancerName *string
+	state            *string
+	createdAt        *time.Time
+	location         *lightsail.ResourceLocation
+	tags             []*lightsail.Tag
 }
 
 func init() {
@@ -30,6 +37,10 @@ func ListLightsailLoadBalancers(sess *session.Session) ([]Resource, error) {
 			resources = append(resources, &LightsailLoadBalancer{
 				svc:              svc,
 				loadBalancerName: loadbalancer.Name,
+				state:            loadbalancer.State,
+				createdAt:        loadbalancer.CreatedAt,
+				location:         loadbalancer.Location,
+				tags:             loadbalancer.Tags,
 			})
 		}
 
@@ -55,3 +66,18 @@ func (f *LightsailLoadBalancer) Remove() error {
 func (f *LightsailLoadBalancer) String() string {
 	return *f.loadBalancerName
 }
+
+func (f *LightsailLoadBalancer) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.loadBalancerName).
+		Set("State", f.state).
+		Set("CreatedAt", f.createdAt)
+	if f.location != nil {
+		properties.Set("Region", f.location.RegionName)
+	}
+	return properties
+}
@@ -1,13 +1,21 @@
 package resources
 
 import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lightsail"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type LightsailStaticIP struct {
 	svc          *lightsail.Lightsail
 	staticIPName *string
+	isAttached   *bool
+	attachedTo   *string
+	createdAt    *time.Time
+	location     *lightsail.ResourceLocation
 }
 
 func init() {
@@ -30,6 +38,10 @@ func ListLightsailStaticIPs(sess *session.Session) ([]Resource, error) {
 			resources = append(resources, &LightsailStaticIP{
 				svc:          svc,
 				staticIPName: staticIP.Name,
+				isAttached:   staticIP.IsAttached,
+				attachedTo:   staticIP.AttachedTo,
+				createdAt:    staticIP.CreatedAt,
+				location:     staticIP.Location,
 			})
 		}
 
@@ -43,7 +55,16 @@ func ListLightsailStaticIPs(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove detaches the static IP first, if it is attached to an instance.
 func (f *LightsailStaticIP) Remove() error {
+	if aws.BoolValue(f.isAttached) {
+		_, err := f.svc.DetachStaticIp(&lightsail.DetachStaticIpInput{
+			StaticIpName: f.staticIPName,
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	_, err := f.svc.ReleaseStaticIp(&lightsail.ReleaseStaticIpInput{
 		StaticIpName: f.staticIPName,
@@ -55,3 +76,14 @@ func (f *LightsailStaticIP) Remove() error {
 func (f *LightsailStaticIP) String() string {
 	return *f.staticIPName
 }
+
+func (f *LightsailStaticIP) Properties() types.Properties {
+	properties := types.NewProperties().
+		Set("Name", f.staticIPName).
+		Set("AttachedTo", f.attachedTo).
+		Set("CreatedAt", f.createdAt)
+	if f.location != nil {
+		properties.Set("Region", f.location.RegionName)
+	}
+	return properties
+}
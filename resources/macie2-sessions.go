@@ -0,0 +1,101 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/macie2"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type MacieSession struct {
+	svc          *macie2.Macie2
+	status       *string
+	createdAt    *time.Time
+	featureFlags config.FeatureFlags
+}
+
+func init() {
+	register("MacieSession", ListMacieSessions)
+}
+
+// ListMacieSessions returns at most one session, since Macie is enabled or
+// disabled per region.
+func ListMacieSessions(sess *session.Session) ([]Resource, error) {
+	svc := macie2.New(sess)
+	resources := []Resource{}
+
+	resp, err := svc.GetMacieSession(&macie2.GetMacieSessionInput{})
+	if err != nil {
+		if IsAWSError(err, macie2.ErrCodeAccessDeniedException) &&
+			strings.Contains(err.Error(), "not enabled") {
+			// Macie is not enabled for this region
+			return resources, nil
+		}
+		return nil, err
+	}
+
+	resources = append(resources, &MacieSession{
+		svc:       svc,
+		status:    resp.Status,
+		createdAt: resp.CreatedAt,
+	})
+
+	return resources, nil
+}
+
+func (f *MacieSession) FeatureFlags(ff config.FeatureFlags) {
+	f.featureFlags = ff
+}
+
+// Filter protects Macie in accounts that manage it for other accounts,
+// because disabling it there would affect the whole organization. These
+// are administrator accounts with members and the management account that
+// designated the administrator.
+func (f *MacieSession) Filter() error {
+	if f.featureFlags.DisableOrganizationMacie {
+		return nil
+	}
+
+	members, err := f.svc.ListMembers(&macie2.ListMembersInput{
+		MaxResults: aws.Int64(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(members.Members) > 0 {
+		return fmt.Errorf("administrator account with members")
+	}
+
+	// Only the management account of the organization may list the
+	// administrator accounts.
+	admins, err := f.svc.ListOrganizationAdminAccounts(&macie2.ListOrganizationAdminAccountsInput{
+		MaxResults: aws.Int64(1),
+	})
+	if err == nil && len(admins.AdminAccounts) > 0 {
+		return fmt.Errorf("management account of the organization")
+	}
+
+	return nil
+}
+
+func (f *MacieSession) Remove() error {
+	_, err := f.svc.DisableMacie(&macie2.DisableMacieInput{})
+	return err
+}
+
+func (f *MacieSession) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("Status", f.status)
+	properties.Set("CreatedAt", f.createdAt)
+	return properties
+}
+
+func (f *MacieSession) String() string {
+	return "macie"
+}
@@ -1,14 +1,18 @@
 package resources
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type MediaConvertPreset struct {
-	svc  *mediaconvert.MediaConvert
-	name *string
+	svc        *mediaconvert.MediaConvert
+	name       *string
+	presetType *string
 }
 
 func init() {
@@ -44,8 +48,9 @@ func ListMediaConvertPresets(sess *session.Session) ([]Resource, error) {
 
 		for _, preset := range output.Presets {
 			resources = append(resources, &MediaConvertPreset{
-				svc:  svc,
-				name: preset.Name,
+				svc:        svc,
+				name:       preset.Name,
+				presetType: preset.Type,
 			})
 		}
 
@@ -68,6 +73,19 @@ func (f *MediaConvertPreset) Remove() error {
 	return err
 }
 
+func (f *MediaConvertPreset) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("Type", f.presetType)
+}
+
+func (f *MediaConvertPreset) Filter() error {
+	if aws.StringValue(f.presetType) == mediaconvert.TypeSystem {
+		return fmt.Errorf("cannot delete system preset")
+	}
+	return nil
+}
+
 func (f *MediaConvertPreset) String() string {
 	return *f.name
 }
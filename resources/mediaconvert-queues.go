@@ -7,11 +7,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/mediaconvert"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type MediaConvertQueue struct {
-	svc  *mediaconvert.MediaConvert
-	name *string
+	svc       *mediaconvert.MediaConvert
+	name      *string
+	status    *string
+	queueType *string
 }
 
 func init() {
@@ -47,8 +50,10 @@ func ListMediaConvertQueues(sess *session.Session) ([]Resource, error) {
 
 		for _, queue := range output.Queues {
 			resources = append(resources, &MediaConvertQueue{
-				svc:  svc,
-				name: queue.Name,
+				svc:       svc,
+				name:      queue.Name,
+				status:    queue.Status,
+				queueType: queue.Type,
 			})
 		}
 
@@ -75,7 +80,17 @@ func (f *MediaConvertQueue) String() string {
 	return *f.name
 }
 
+func (f *MediaConvertQueue) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("Status", f.status).
+		Set("Type", f.queueType)
+}
+
 func (f *MediaConvertQueue) Filter() error {
+	if aws.StringValue(f.queueType) == mediaconvert.TypeSystem {
+		return fmt.Errorf("cannot delete system queue")
+	}
 	if strings.Contains(*f.name, "Default") {
 		return fmt.Errorf("cannot delete default queue")
 	}
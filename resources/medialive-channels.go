@@ -1,14 +1,20 @@
 package resources
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type MediaLiveChannel struct {
-	svc *medialive.MediaLive
-	ID  *string
+	svc   *medialive.MediaLive
+	ID    *string
+	name  *string
+	state *string
+	tags  map[string]*string
 }
 
 func init() {
@@ -31,8 +37,11 @@ func ListMediaLiveChannels(sess *session.Session) ([]Resource, error) {
 
 		for _, channel := range output.Channels {
 			resources = append(resources, &MediaLiveChannel{
-				svc: svc,
-				ID:  channel.Id,
+				svc:   svc,
+				ID:    channel.Id,
+				name:  channel.Name,
+				state: channel.State,
+				tags:  channel.Tags,
 			})
 		}
 
@@ -46,15 +55,54 @@ func ListMediaLiveChannels(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove stops a running channel and deletes it once it is idle. Deleting the
+// channel also detaches its inputs.
 func (f *MediaLiveChannel) Remove() error {
+	resp, err := f.svc.DescribeChannel(&medialive.DescribeChannelInput{
+		ChannelId: f.ID,
+	})
+	if err != nil {
+		return err
+	}
 
-	_, err := f.svc.DeleteChannel(&medialive.DeleteChannelInput{
+	f.state = resp.State
+
+	switch aws.StringValue(f.state) {
+	case medialive.ChannelStateDeleting, medialive.ChannelStateDeleted:
+		return nil
+	case medialive.ChannelStateRunning, medialive.ChannelStateRecovering:
+		_, err := f.svc.StopChannel(&medialive.StopChannelInput{
+			ChannelId: f.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("stopping channel before deletion")
+	case medialive.ChannelStateCreating, medialive.ChannelStateStarting,
+		medialive.ChannelStateStopping, medialive.ChannelStateUpdating:
+		return fmt.Errorf("waiting for channel in state %s", *f.state)
+	}
+
+	_, err = f.svc.DeleteChannel(&medialive.DeleteChannelInput{
 		ChannelId: f.ID,
 	})
 
 	return err
 }
 
+func (f *MediaLiveChannel) Properties() types.Properties {
+	properties := types.NewProperties()
+	for key, value := range f.tags {
+		properties.SetTag(aws.String(key), value)
+	}
+	properties.
+		Set("ID", f.ID).
+		Set("Name", f.name).
+		Set("State", f.state)
+	return properties
+}
+
 func (f *MediaLiveChannel) String() string {
 	return *f.ID
 }
@@ -1,14 +1,20 @@
 package resources
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type MediaLiveInput struct {
-	svc *medialive.MediaLive
-	ID  *string
+	svc   *medialive.MediaLive
+	ID    *string
+	name  *string
+	state *string
+	tags  map[string]*string
 }
 
 func init() {
@@ -31,8 +37,11 @@ func ListMediaLiveInputs(sess *session.Session) ([]Resource, error) {
 
 		for _, input := range output.Inputs {
 			resources = append(resources, &MediaLiveInput{
-				svc: svc,
-				ID:  input.Id,
+				svc:   svc,
+				ID:    input.Id,
+				name:  input.Name,
+				state: input.State,
+				tags:  input.Tags,
 			})
 		}
 
@@ -46,15 +55,44 @@ func ListMediaLiveInputs(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the input. Inputs cannot be detached on their own, so the
+// deletion waits until the MediaLiveChannel using it is deleted.
 func (f *MediaLiveInput) Remove() error {
+	resp, err := f.svc.DescribeInput(&medialive.DescribeInputInput{
+		InputId: f.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	f.state = resp.State
 
-	_, err := f.svc.DeleteInput(&medialive.DeleteInputInput{
+	switch aws.StringValue(f.state) {
+	case medialive.InputStateDeleting, medialive.InputStateDeleted:
+		return nil
+	case medialive.InputStateAttached:
+		return fmt.Errorf("waiting for input to be detached from its channels")
+	}
+
+	_, err = f.svc.DeleteInput(&medialive.DeleteInputInput{
 		InputId: f.ID,
 	})
 
 	return err
 }
 
+func (f *MediaLiveInput) Properties() types.Properties {
+	properties := types.NewProperties()
+	for key, value := range f.tags {
+		properties.SetTag(aws.String(key), value)
+	}
+	properties.
+		Set("ID", f.ID).
+		Set("Name", f.name).
+		Set("State", f.state)
+	return properties
+}
+
 func (f *MediaLiveInput) String() string {
 	return *f.ID
 }
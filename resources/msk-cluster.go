@@ -1,15 +1,22 @@
 package resources
 
 import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/kafka"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type MSKCluster struct {
-	svc  *kafka.Kafka
-	arn  string
-	name string
+	svc            *kafka.Kafka
+	arn            string
+	name           string
+	state          *string
+	currentVersion *string
+	creationTime   *time.Time
+	tags           map[string]*string
 }
 
 func init() {
@@ -18,27 +25,49 @@ func init() {
 
 func ListMSKCluster(sess *session.Session) ([]Resource, error) {
 	svc := kafka.New(sess)
-	params := &kafka.ListClustersInput{}
-	resp, err := svc.ListClusters(params)
+	resources := make([]Resource, 0)
 
-	if err != nil {
-		return nil, err
+	params := &kafka.ListClustersInput{
+		MaxResults: aws.Int64(100),
 	}
-	resources := make([]Resource, 0)
-	for _, cluster := range resp.ClusterInfoList {
-		resources = append(resources, &MSKCluster{
-			svc:  svc,
-			arn:  *cluster.ClusterArn,
-			name: *cluster.ClusterName,
-		})
 
+	for {
+		resp, err := svc.ListClusters(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, cluster := range resp.ClusterInfoList {
+			resources = append(resources, &MSKCluster{
+				svc:            svc,
+				arn:            *cluster.ClusterArn,
+				name:           *cluster.ClusterName,
+				state:          cluster.State,
+				currentVersion: cluster.CurrentVersion,
+				creationTime:   cluster.CreationTime,
+				tags:           cluster.Tags,
+			})
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		params.NextToken = resp.NextToken
 	}
+
 	return resources, nil
 }
 
 func (m *MSKCluster) Remove() error {
+	if aws.StringValue(m.state) == kafka.ClusterStateDeleting {
+		// The cluster stays in the list until the deletion is complete.
+		return nil
+	}
+
 	params := &kafka.DeleteClusterInput{
-		ClusterArn: &m.arn,
+		ClusterArn:     &m.arn,
+		CurrentVersion: m.currentVersion,
 	}
 
 	_, err := m.svc.DeleteCluster(params)
@@ -54,8 +83,13 @@ func (m *MSKCluster) String() string {
 
 func (m *MSKCluster) Properties() types.Properties {
 	properties := types.NewProperties()
+	for key, value := range m.tags {
+		properties.SetTag(aws.String(key), value)
+	}
 	properties.Set("ARN", m.arn)
 	properties.Set("Name", m.name)
+	properties.Set("State", m.state)
+	properties.Set("CreationTime", m.creationTime)
 
 	return properties
 }
@@ -4,11 +4,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type NeptuneCluster struct {
-	svc *neptune.Neptune
-	ID  *string
+	svc                *neptune.Neptune
+	ID                 *string
+	engine             *string
+	status             *string
+	deletionProtection *bool
 }
 
 func init() {
@@ -19,8 +23,14 @@ func ListNeptuneClusters(sess *session.Session) ([]Resource, error) {
 	svc := neptune.New(sess)
 	resources := []Resource{}
 
+	// The API is shared with RDS, therefore it also returns the clusters of
+	// other engines.
 	params := &neptune.DescribeDBClustersInput{
 		MaxRecords: aws.Int64(100),
+		Filters: []*neptune.Filter{{
+			Name:   aws.String("engine"),
+			Values: []*string{aws.String("neptune")},
+		}},
 	}
 
 	for {
@@ -31,8 +41,11 @@ func ListNeptuneClusters(sess *session.Session) ([]Resource, error) {
 
 		for _, dbCluster := range output.DBClusters {
 			resources = append(resources, &NeptuneCluster{
-				svc: svc,
-				ID:  dbCluster.DBClusterIdentifier,
+				svc:                svc,
+				ID:                 dbCluster.DBClusterIdentifier,
+				engine:             dbCluster.Engine,
+				status:             dbCluster.Status,
+				deletionProtection: dbCluster.DeletionProtection,
 			})
 		}
 
@@ -47,6 +60,16 @@ func ListNeptuneClusters(sess *session.Session) ([]Resource, error) {
 }
 
 func (f *NeptuneCluster) Remove() error {
+	if aws.BoolValue(f.deletionProtection) {
+		_, err := f.svc.ModifyDBCluster(&neptune.ModifyDBClusterInput{
+			DBClusterIdentifier: f.ID,
+			DeletionProtection:  aws.Bool(false),
+			ApplyImmediately:    aws.Bool(true),
+		})
+		if err != nil {
+			return err
+		}
+	}
 
 	_, err := f.svc.DeleteDBCluster(&neptune.DeleteDBClusterInput{
 		DBClusterIdentifier: f.ID,
@@ -59,3 +82,11 @@ func (f *NeptuneCluster) Remove() error {
 func (f *NeptuneCluster) String() string {
 	return *f.ID
 }
+
+func (f *NeptuneCluster) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Identifier", f.ID).
+		Set("Engine", f.engine).
+		Set("Status", f.status).
+		Set("DeletionProtection", f.deletionProtection)
+}
@@ -4,11 +4,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/neptune"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type NeptuneInstance struct {
-	svc *neptune.Neptune
-	ID  *string
+	svc       *neptune.Neptune
+	ID        *string
+	clusterID *string
+	engine    *string
+	status    *string
 }
 
 func init() {
@@ -19,8 +23,14 @@ func ListNeptuneInstances(sess *session.Session) ([]Resource, error) {
 	svc := neptune.New(sess)
 	resources := []Resource{}
 
+	// The API is shared with RDS, therefore it also returns the instances of
+	// other engines.
 	params := &neptune.DescribeDBInstancesInput{
 		MaxRecords: aws.Int64(100),
+		Filters: []*neptune.Filter{{
+			Name:   aws.String("engine"),
+			Values: []*string{aws.String("neptune")},
+		}},
 	}
 
 	for {
@@ -31,8 +41,11 @@ func ListNeptuneInstances(sess *session.Session) ([]Resource, error) {
 
 		for _, dbInstance := range output.DBInstances {
 			resources = append(resources, &NeptuneInstance{
-				svc: svc,
-				ID:  dbInstance.DBInstanceIdentifier,
+				svc:       svc,
+				ID:        dbInstance.DBInstanceIdentifier,
+				clusterID: dbInstance.DBClusterIdentifier,
+				engine:    dbInstance.Engine,
+				status:    dbInstance.DBInstanceStatus,
 			})
 		}
 
@@ -47,7 +60,6 @@ func ListNeptuneInstances(sess *session.Session) ([]Resource, error) {
 }
 
 func (f *NeptuneInstance) Remove() error {
-
 	_, err := f.svc.DeleteDBInstance(&neptune.DeleteDBInstanceInput{
 		DBInstanceIdentifier: f.ID,
 		SkipFinalSnapshot:    aws.Bool(true),
@@ -59,3 +71,11 @@ func (f *NeptuneInstance) Remove() error {
 func (f *NeptuneInstance) String() string {
 	return *f.ID
 }
+
+func (f *NeptuneInstance) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Identifier", f.ID).
+		Set("ClusterIdentifier", f.clusterID).
+		Set("Engine", f.engine).
+		Set("Status", f.status)
+}
@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type NetworkFirewallFirewallPolicy struct {
+	svc  *networkfirewall.NetworkFirewall
+	name *string
+	arn  *string
+}
+
+func init() {
+	register("NetworkFirewallFirewallPolicy", ListNetworkFirewallFirewallPolicies)
+}
+
+func ListNetworkFirewallFirewallPolicies(sess *session.Session) ([]Resource, error) {
+	svc := networkfirewall.New(sess)
+	resources := []Resource{}
+
+	params := &networkfirewall.ListFirewallPoliciesInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListFirewallPolicies(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, policy := range output.FirewallPolicies {
+			resources = append(resources, &NetworkFirewallFirewallPolicy{
+				svc:  svc,
+				name: policy.Name,
+				arn:  policy.Arn,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove fails as long as a firewall uses the policy and gets retried until
+// the NetworkFirewallFirewall is deleted.
+func (f *NetworkFirewallFirewallPolicy) Remove() error {
+	_, err := f.svc.DeleteFirewallPolicy(&networkfirewall.DeleteFirewallPolicyInput{
+		FirewallPolicyArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *NetworkFirewallFirewallPolicy) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("ARN", f.arn)
+}
+
+func (f *NetworkFirewallFirewallPolicy) String() string {
+	return *f.name
+}
@@ -0,0 +1,112 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type NetworkFirewallFirewall struct {
+	svc              *networkfirewall.NetworkFirewall
+	name             *string
+	arn              *string
+	vpcID            *string
+	status           *string
+	deleteProtection *bool
+	tags             []*networkfirewall.Tag
+}
+
+func init() {
+	register("NetworkFirewallFirewall", ListNetworkFirewallFirewalls)
+}
+
+func ListNetworkFirewallFirewalls(sess *session.Session) ([]Resource, error) {
+	svc := networkfirewall.New(sess)
+	resources := []Resource{}
+
+	params := &networkfirewall.ListFirewallsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListFirewalls(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, metadata := range output.Firewalls {
+			resp, err := svc.DescribeFirewall(&networkfirewall.DescribeFirewallInput{
+				FirewallArn: metadata.FirewallArn,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			firewall := &NetworkFirewallFirewall{
+				svc:              svc,
+				name:             resp.Firewall.FirewallName,
+				arn:              resp.Firewall.FirewallArn,
+				vpcID:            resp.Firewall.VpcId,
+				deleteProtection: resp.Firewall.DeleteProtection,
+				tags:             resp.Firewall.Tags,
+			}
+			if resp.FirewallStatus != nil {
+				firewall.status = resp.FirewallStatus.Status
+			}
+
+			resources = append(resources, firewall)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove disables the deletion protection first, if it is set. The firewall
+// policy and the rule groups can only be deleted after the firewall is gone.
+func (f *NetworkFirewallFirewall) Remove() error {
+	if aws.StringValue(f.status) == networkfirewall.FirewallStatusValueDeleting {
+		return nil
+	}
+
+	if aws.BoolValue(f.deleteProtection) {
+		_, err := f.svc.UpdateFirewallDeleteProtection(&networkfirewall.UpdateFirewallDeleteProtectionInput{
+			FirewallArn:      f.arn,
+			DeleteProtection: aws.Bool(false),
+		})
+		if err != nil {
+			return err
+		}
+
+		f.deleteProtection = aws.Bool(false)
+	}
+
+	_, err := f.svc.DeleteFirewall(&networkfirewall.DeleteFirewallInput{
+		FirewallArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *NetworkFirewallFirewall) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.name).
+		Set("ARN", f.arn).
+		Set("VpcID", f.vpcID).
+		Set("Status", f.status)
+	return properties
+}
+
+func (f *NetworkFirewallFirewall) String() string {
+	return *f.name
+}
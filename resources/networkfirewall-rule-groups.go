@@ -0,0 +1,70 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/networkfirewall"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type NetworkFirewallRuleGroup struct {
+	svc  *networkfirewall.NetworkFirewall
+	name *string
+	arn  *string
+}
+
+func init() {
+	register("NetworkFirewallRuleGroup", ListNetworkFirewallRuleGroups)
+}
+
+func ListNetworkFirewallRuleGroups(sess *session.Session) ([]Resource, error) {
+	svc := networkfirewall.New(sess)
+	resources := []Resource{}
+
+	params := &networkfirewall.ListRuleGroupsInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListRuleGroups(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range output.RuleGroups {
+			resources = append(resources, &NetworkFirewallRuleGroup{
+				svc:  svc,
+				name: group.Name,
+				arn:  group.Arn,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove fails as long as a firewall policy references the rule group and
+// gets retried until the NetworkFirewallFirewallPolicy is deleted.
+func (f *NetworkFirewallRuleGroup) Remove() error {
+	_, err := f.svc.DeleteRuleGroup(&networkfirewall.DeleteRuleGroupInput{
+		RuleGroupArn: f.arn,
+	})
+
+	return err
+}
+
+func (f *NetworkFirewallRuleGroup) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("ARN", f.arn)
+}
+
+func (f *NetworkFirewallRuleGroup) String() string {
+	return *f.name
+}
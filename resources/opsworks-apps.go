@@ -3,11 +3,14 @@ package resources
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type OpsWorksApp struct {
-	svc *opsworks.OpsWorks
-	ID  *string
+	svc     *opsworks.OpsWorks
+	ID      *string
+	name    *string
+	stackID *string
 }
 
 func init() {
@@ -36,8 +39,10 @@ func ListOpsWorksApps(sess *session.Session) ([]Resource, error) {
 
 		for _, app := range output.Apps {
 			resources = append(resources, &OpsWorksApp{
-				svc: svc,
-				ID:  app.AppId,
+				svc:     svc,
+				ID:      app.AppId,
+				name:    app.Name,
+				stackID: app.StackId,
 			})
 		}
 
@@ -54,6 +59,13 @@ func (f *OpsWorksApp) Remove() error {
 	return err
 }
 
+func (f *OpsWorksApp) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ID", f.ID).
+		Set("Name", f.name).
+		Set("StackID", f.stackID)
+}
+
 func (f *OpsWorksApp) String() string {
 	return *f.ID
 }
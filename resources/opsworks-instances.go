@@ -1,13 +1,20 @@
 package resources
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type OpsWorksInstance struct {
-	svc *opsworks.OpsWorks
-	ID  *string
+	svc      *opsworks.OpsWorks
+	ID       *string
+	hostname *string
+	status   *string
+	stackID  *string
 }
 
 func init() {
@@ -35,8 +42,11 @@ func ListOpsWorksInstances(sess *session.Session) ([]Resource, error) {
 
 		for _, instance := range output.Instances {
 			resources = append(resources, &OpsWorksInstance{
-				svc: svc,
-				ID:  instance.InstanceId,
+				svc:      svc,
+				ID:       instance.InstanceId,
+				hostname: instance.Hostname,
+				status:   instance.Status,
+				stackID:  instance.StackId,
 			})
 		}
 	}
@@ -44,15 +54,51 @@ func ListOpsWorksInstances(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove stops the instance and deletes it once it is stopped. Deleting the
+// underlying EC2 instance directly does not help, since OpsWorks would start
+// a new one.
 func (f *OpsWorksInstance) Remove() error {
+	resp, err := f.svc.DescribeInstances(&opsworks.DescribeInstancesInput{
+		InstanceIds: []*string{f.ID},
+	})
+	if err != nil {
+		return err
+	}
 
-	_, err := f.svc.DeleteInstance(&opsworks.DeleteInstanceInput{
+	if len(resp.Instances) > 0 {
+		f.status = resp.Instances[0].Status
+	}
+
+	switch aws.StringValue(f.status) {
+	case "stopped":
+	case "stopping", "shutting_down", "terminating":
+		return fmt.Errorf("waiting for instance to stop")
+	default:
+		_, err := f.svc.StopInstance(&opsworks.StopInstanceInput{
+			InstanceId: f.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("stopping instance before deletion")
+	}
+
+	_, err = f.svc.DeleteInstance(&opsworks.DeleteInstanceInput{
 		InstanceId: f.ID,
 	})
 
 	return err
 }
 
+func (f *OpsWorksInstance) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ID", f.ID).
+		Set("Hostname", f.hostname).
+		Set("Status", f.status).
+		Set("StackID", f.stackID)
+}
+
 func (f *OpsWorksInstance) String() string {
 	return *f.ID
 }
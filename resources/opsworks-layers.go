@@ -3,11 +3,14 @@ package resources
 import (
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type OpsWorksLayer struct {
-	svc *opsworks.OpsWorks
-	ID  *string
+	svc     *opsworks.OpsWorks
+	ID      *string
+	name    *string
+	stackID *string
 }
 
 func init() {
@@ -36,8 +39,10 @@ func ListOpsWorksLayers(sess *session.Session) ([]Resource, error) {
 
 		for _, layer := range output.Layers {
 			resources = append(resources, &OpsWorksLayer{
-				svc: svc,
-				ID:  layer.LayerId,
+				svc:     svc,
+				ID:      layer.LayerId,
+				name:    layer.Name,
+				stackID: layer.StackId,
 			})
 		}
 	}
@@ -54,6 +59,13 @@ func (f *OpsWorksLayer) Remove() error {
 	return err
 }
 
+func (f *OpsWorksLayer) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ID", f.ID).
+		Set("Name", f.name).
+		Set("StackID", f.stackID)
+}
+
 func (f *OpsWorksLayer) String() string {
 	return *f.ID
 }
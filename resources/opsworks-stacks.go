@@ -0,0 +1,63 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/opsworks"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type OpsWorksStack struct {
+	svc       *opsworks.OpsWorks
+	ID        *string
+	name      *string
+	vpcID     *string
+	createdAt *string
+}
+
+func init() {
+	register("OpsWorksStack", ListOpsWorksStacks)
+}
+
+func ListOpsWorksStacks(sess *session.Session) ([]Resource, error) {
+	svc := opsworks.New(sess)
+	resources := []Resource{}
+
+	resp, err := svc.DescribeStacks(&opsworks.DescribeStacksInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stack := range resp.Stacks {
+		resources = append(resources, &OpsWorksStack{
+			svc:       svc,
+			ID:        stack.StackId,
+			name:      stack.Name,
+			vpcID:     stack.VpcId,
+			createdAt: stack.CreatedAt,
+		})
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the stack. This fails as long as the stack has instances,
+// layers or apps, and gets retried until those are gone.
+func (f *OpsWorksStack) Remove() error {
+	_, err := f.svc.DeleteStack(&opsworks.DeleteStackInput{
+		StackId: f.ID,
+	})
+
+	return err
+}
+
+func (f *OpsWorksStack) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ID", f.ID).
+		Set("Name", f.name).
+		Set("VpcID", f.vpcID).
+		Set("CreatedAt", f.createdAt)
+}
+
+func (f *OpsWorksStack) String() string {
+	return *f.ID
+}
@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/pinpoint"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type PinpointApp struct {
+	svc  *pinpoint.Pinpoint
+	id   *string
+	name *string
+	tags map[string]*string
+}
+
+func init() {
+	register("PinpointApp", ListPinpointApps)
+}
+
+func ListPinpointApps(sess *session.Session) ([]Resource, error) {
+	svc := pinpoint.New(sess)
+	resources := []Resource{}
+
+	params := &pinpoint.GetAppsInput{
+		PageSize: aws.String("100"),
+	}
+
+	for {
+		resp, err := svc.GetApps(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, app := range resp.ApplicationsResponse.Item {
+			resources = append(resources, &PinpointApp{
+				svc:  svc,
+				id:   app.Id,
+				name: app.Name,
+				tags: app.Tags,
+			})
+		}
+
+		if resp.ApplicationsResponse.NextToken == nil {
+			break
+		}
+
+		params.Token = resp.ApplicationsResponse.NextToken
+	}
+
+	return resources, nil
+}
+
+// Remove deletes the app. Segments, campaigns and the other child resources
+// of the app are deleted along with it.
+func (f *PinpointApp) Remove() error {
+	_, err := f.svc.DeleteApp(&pinpoint.DeleteAppInput{
+		ApplicationId: f.id,
+	})
+
+	return err
+}
+
+func (f *PinpointApp) Properties() types.Properties {
+	properties := types.NewProperties()
+	for key, value := range f.tags {
+		properties.SetTag(aws.String(key), value)
+	}
+	properties.
+		Set("ID", f.id).
+		Set("Name", f.name)
+	return properties
+}
+
+func (f *PinpointApp) String() string {
+	return *f.id
+}
@@ -0,0 +1,89 @@
+package resources
+
+// HoursPerMonth is the number of hours AWS uses to calculate monthly prices.
+const HoursPerMonth = 730
+
+// The prices are rough on-demand prices in USD for Linux in us-east-1. They
+// are only meant to give an idea of the cost of resources and do not consider
+// other regions, reservations, savings plans or free tiers.
+var (
+	ec2InstanceHourlyPrices = map[string]float64{
+		"t2.nano":     0.0058,
+		"t2.micro":    0.0116,
+		"t2.small":    0.023,
+		"t2.medium":   0.0464,
+		"t2.large":    0.0928,
+		"t2.xlarge":   0.1856,
+		"t2.2xlarge":  0.3712,
+		"t3.nano":     0.0052,
+		"t3.micro":    0.0104,
+		"t3.small":    0.0208,
+		"t3.medium":   0.0416,
+		"t3.large":    0.0832,
+		"t3.xlarge":   0.1664,
+		"t3.2xlarge":  0.3328,
+		"t3a.nano":    0.0047,
+		"t3a.micro":   0.0094,
+		"t3a.small":   0.0188,
+		"t3a.medium":  0.0376,
+		"t3a.large":   0.0752,
+		"t3a.xlarge":  0.1504,
+		"t3a.2xlarge": 0.3008,
+		"m5.large":    0.096,
+		"m5.xlarge":   0.192,
+		"m5.2xlarge":  0.384,
+		"m5.4xlarge":  0.768,
+		"m5.8xlarge":  1.536,
+		"m5.12xlarge": 2.304,
+		"m5.16xlarge": 3.072,
+		"m5.24xlarge": 4.608,
+		"c5.large":    0.085,
+		"c5.xlarge":   0.17,
+		"c5.2xlarge":  0.34,
+		"c5.4xlarge":  0.68,
+		"c5.9xlarge":  1.53,
+		"c5.18xlarge": 3.06,
+		"r5.large":    0.126,
+		"r5.xlarge":   0.252,
+		"r5.2xlarge":  0.504,
+		"r5.4xlarge":  1.008,
+		"r5.8xlarge":  2.016,
+		"r5.12xlarge": 3.024,
+	}
+
+	rdsInstanceHourlyPrices = map[string]float64{
+		"db.t2.micro":    0.017,
+		"db.t2.small":    0.034,
+		"db.t2.medium":   0.068,
+		"db.t2.large":    0.136,
+		"db.t3.micro":    0.017,
+		"db.t3.small":    0.034,
+		"db.t3.medium":   0.068,
+		"db.t3.large":    0.136,
+		"db.t3.xlarge":   0.272,
+		"db.t3.2xlarge":  0.544,
+		"db.m5.large":    0.171,
+		"db.m5.xlarge":   0.342,
+		"db.m5.2xlarge":  0.684,
+		"db.m5.4xlarge":  1.368,
+		"db.m5.12xlarge": 4.104,
+		"db.r5.large":    0.25,
+		"db.r5.xlarge":   0.5,
+		"db.r5.2xlarge":  1.0,
+		"db.r5.4xlarge":  2.0,
+		"db.r5.12xlarge": 6.0,
+	}
+
+	ebsVolumeMonthlyPricesPerGB = map[string]float64{
+		"standard": 0.05,
+		"gp2":      0.10,
+		"gp3":      0.08,
+		"io1":      0.125,
+		"io2":      0.125,
+		"st1":      0.045,
+		"sc1":      0.015,
+	}
+
+	natGatewayHourlyPrice = 0.045
+	elasticIPHourlyPrice  = 0.005
+)
@@ -0,0 +1,124 @@
+package resources
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/qldb"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type QLDBLedger struct {
+	svc                *qldb.QLDB
+	name               *string
+	state              *string
+	deletionProtection *bool
+	creationTime       *time.Time
+	tags               map[string]*string
+}
+
+func init() {
+	register("QLDBLedger", ListQLDBLedgers)
+}
+
+func ListQLDBLedgers(sess *session.Session) ([]Resource, error) {
+	svc := qldb.New(sess)
+	resources := []Resource{}
+
+	params := &qldb.ListLedgersInput{
+		MaxResults: aws.Int64(100),
+	}
+
+	for {
+		output, err := svc.ListLedgers(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ledger := range output.Ledgers {
+			resp, err := svc.DescribeLedger(&qldb.DescribeLedgerInput{
+				Name: ledger.Name,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			tags, err := svc.ListTagsForResource(&qldb.ListTagsForResourceInput{
+				ResourceArn: resp.Arn,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			resources = append(resources, &QLDBLedger{
+				svc:                svc,
+				name:               resp.Name,
+				state:              resp.State,
+				deletionProtection: resp.DeletionProtection,
+				creationTime:       resp.CreationDateTime,
+				tags:               tags.Tags,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+func (f *QLDBLedger) Filter() error {
+	if aws.StringValue(f.state) == qldb.LedgerStateDeleted {
+		return fmt.Errorf("already deleted")
+	}
+
+	return nil
+}
+
+// Remove disables the deletion protection first, if it is set.
+func (f *QLDBLedger) Remove() error {
+	if aws.StringValue(f.state) == qldb.LedgerStateDeleting {
+		return nil
+	}
+
+	if aws.BoolValue(f.deletionProtection) {
+		_, err := f.svc.UpdateLedger(&qldb.UpdateLedgerInput{
+			Name:               f.name,
+			DeletionProtection: aws.Bool(false),
+		})
+		if err != nil {
+			return err
+		}
+
+		f.deletionProtection = aws.Bool(false)
+	}
+
+	_, err := f.svc.DeleteLedger(&qldb.DeleteLedgerInput{
+		Name: f.name,
+	})
+
+	return err
+}
+
+func (f *QLDBLedger) Properties() types.Properties {
+	properties := types.NewProperties()
+	for key, value := range f.tags {
+		properties.SetTag(aws.String(key), value)
+	}
+	properties.Set("Name", f.name)
+	properties.Set("State", f.state)
+	properties.Set("DeletionProtection", f.deletionProtection)
+	if f.creationTime != nil {
+		properties.Set("CreationTime", f.creationTime.Format(time.RFC3339))
+	}
+	return properties
+}
+
+func (f *QLDBLedger) String() string {
+	return *f.name
+}
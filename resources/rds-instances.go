@@ -88,6 +88,7 @@ func (i *RDSInstance) Properties() types.Properties {
 	properties.Set("EngineVersion", i.instance.EngineVersion)
 	properties.Set("MultiAZ", i.instance.MultiAZ)
 	properties.Set("PubliclyAccessible", i.instance.PubliclyAccessible)
+	properties.Set("InstanceCreateTime", i.instance.InstanceCreateTime)
 
 	for _, tag := range i.tags {
 		properties.SetTag(tag.Key, tag.Value)
@@ -96,6 +97,15 @@ func (i *RDSInstance) Properties() types.Properties {
 	return properties
 }
 
+// EstimatedMonthlyCost only covers the instance, but not its storage.
+func (i *RDSInstance) EstimatedMonthlyCost() (float64, bool) {
+	price, ok := rdsInstanceHourlyPrices[aws.StringValue(i.instance.DBInstanceClass)]
+	if aws.BoolValue(i.instance.MultiAZ) {
+		price = price * 2
+	}
+	return price * HoursPerMonth, ok
+}
+
 func (i *RDSInstance) String() string {
 	return aws.StringValue(i.instance.DBInstanceIdentifier)
 }
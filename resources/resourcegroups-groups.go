@@ -4,11 +4,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/resourcegroups"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type ResourceGroupGroup struct {
-	svc       *resourcegroups.ResourceGroups
-	groupName *string
+	svc         *resourcegroups.ResourceGroups
+	groupName   *string
+	description *string
+	tags        map[string]*string
 }
 
 func init() {
@@ -30,9 +33,18 @@ func ListResourceGroupGroups(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, group := range output.Groups {
+			tags, err := svc.GetTags(&resourcegroups.GetTagsInput{
+				Arn: group.GroupArn,
+			})
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &ResourceGroupGroup{
-				svc:       svc,
-				groupName: group.Name,
+				svc:         svc,
+				groupName:   group.Name,
+				description: group.Description,
+				tags:        tags.Tags,
 			})
 		}
 
@@ -55,6 +67,17 @@ func (f *ResourceGroupGroup) Remove() error {
 	return err
 }
 
+func (f *ResourceGroupGroup) Properties() types.Properties {
+	properties := types.NewProperties()
+	for key, value := range f.tags {
+		properties.SetTag(aws.String(key), value)
+	}
+	properties.
+		Set("Name", f.groupName).
+		Set("Description", f.description)
+	return properties
+}
+
 func (f *ResourceGroupGroup) String() string {
 	return *f.groupName
 }
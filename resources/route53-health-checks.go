@@ -10,7 +10,7 @@ import (
 )
 
 func init() {
-	register("Route53HealthCheck", ListRoute53HealthChecks)
+	registerGlobal("Route53HealthCheck", ListRoute53HealthChecks)
 }
 
 func ListRoute53HealthChecks(sess *session.Session) ([]Resource, error) {
@@ -9,7 +9,7 @@ import (
 )
 
 func init() {
-	register("Route53HostedZone", ListRoute53HostedZones)
+	registerGlobal("Route53HostedZone", ListRoute53HostedZones)
 }
 
 func ListRoute53HostedZones(sess *session.Session) ([]Resource, error) {
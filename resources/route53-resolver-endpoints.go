@@ -3,6 +3,7 @@ package resources
 import (
 	"fmt"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/route53resolver"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
@@ -10,9 +11,12 @@ import (
 
 // Route53ResolverEndpoint is the resource type for nuking
 type Route53ResolverEndpoint struct {
-	svc  *route53resolver.Route53Resolver
-	id   *string
-	name *string
+	svc       *route53resolver.Route53Resolver
+	id        *string
+	name      *string
+	direction *string
+	vpcID     *string
+	status    *string
 }
 
 func init() {
@@ -36,9 +40,12 @@ func ListRoute53ResolverEndpoints(sess *session.Session) ([]Resource, error) {
 
 		for _, endpoint := range resp.ResolverEndpoints {
 			resolverEndpoint := &Route53ResolverEndpoint{
-				svc:  svc,
-				id:   endpoint.Id,
-				name: endpoint.Name,
+				svc:       svc,
+				id:        endpoint.Id,
+				name:      endpoint.Name,
+				direction: endpoint.Direction,
+				vpcID:     endpoint.HostVPCId,
+				status:    endpoint.Status,
 			}
 
 			resources = append(resources, resolverEndpoint)
@@ -56,6 +63,10 @@ func ListRoute53ResolverEndpoints(sess *session.Session) ([]Resource, error) {
 
 // Remove implements Resource
 func (r *Route53ResolverEndpoint) Remove() error {
+	if aws.StringValue(r.status) == route53resolver.ResolverEndpointStatusDeleting {
+		return nil
+	}
+
 	_, err := r.svc.DeleteResolverEndpoint(
 		&route53resolver.DeleteResolverEndpointInput{
 			ResolverEndpointId: r.id,
@@ -72,7 +83,10 @@ func (r *Route53ResolverEndpoint) Remove() error {
 func (r *Route53ResolverEndpoint) Properties() types.Properties {
 	return types.NewProperties().
 		Set("EndpointID", r.id).
-		Set("Name", r.name)
+		Set("Name", r.name).
+		Set("Direction", r.direction).
+		Set("VpcID", r.vpcID).
+		Set("Status", r.status)
 }
 
 // String implements Stringer
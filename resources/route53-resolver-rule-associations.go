@@ -0,0 +1,100 @@
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/route53resolver"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+// Route53ResolverRuleAssociation is the resource type
+type Route53ResolverRuleAssociation struct {
+	svc            *route53resolver.Route53Resolver
+	id             *string
+	name           *string
+	resolverRuleID *string
+	vpcID          *string
+	status         *string
+}
+
+func init() {
+	register("Route53ResolverRuleAssociation", ListRoute53ResolverRuleAssociations)
+}
+
+// ListRoute53ResolverRuleAssociations produces the resources to be nuked
+func ListRoute53ResolverRuleAssociations(sess *session.Session) ([]Resource, error) {
+	svc := route53resolver.New(sess)
+
+	params := &route53resolver.ListResolverRuleAssociationsInput{}
+
+	var resources []Resource
+
+	for {
+		resp, err := svc.ListResolverRuleAssociations(params)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, association := range resp.ResolverRuleAssociations {
+			resources = append(resources, &Route53ResolverRuleAssociation{
+				svc:            svc,
+				id:             association.Id,
+				name:           association.Name,
+				resolverRuleID: association.ResolverRuleId,
+				vpcID:          association.VPCId,
+				status:         association.Status,
+			})
+		}
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		params.NextToken = resp.NextToken
+	}
+
+	return resources, nil
+}
+
+// Filter removes resources automatically from being nuked
+func (r *Route53ResolverRuleAssociation) Filter() error {
+	if strings.HasPrefix(aws.StringValue(r.resolverRuleID), "rslvr-autodefined-") {
+		return fmt.Errorf("association of an autodefined rule")
+	}
+
+	return nil
+}
+
+// Remove implements Resource. Rules can only be deleted after all of their
+// associations are gone.
+func (r *Route53ResolverRuleAssociation) Remove() error {
+	if aws.StringValue(r.status) == route53resolver.ResolverRuleAssociationStatusDeleting {
+		return nil
+	}
+
+	_, err := r.svc.DisassociateResolverRule(&route53resolver.DisassociateResolverRuleInput{
+		ResolverRuleId: r.resolverRuleID,
+		VPCId:          r.vpcID,
+	})
+
+	return err
+}
+
+// Properties provides debugging output
+func (r *Route53ResolverRuleAssociation) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ID", r.id).
+		Set("Name", r.name).
+		Set("ResolverRuleID", r.resolverRuleID).
+		Set("VpcID", r.vpcID).
+		Set("Status", r.status)
+}
+
+// String implements Stringer
+func (r *Route53ResolverRuleAssociation) String() string {
+	return fmt.Sprintf("%s -> %s", *r.resolverRuleID, *r.vpcID)
+}
@@ -15,6 +15,8 @@ type (
 		id         *string
 		name       *string
 		domainName *string
+		ruleType   *string
+		status     *string
 		vpcIds     []*string
 	}
 )
@@ -48,6 +50,8 @@ func ListRoute53ResolverRules(sess *session.Session) ([]Resource, error) {
 				id:         rule.Id,
 				name:       rule.Name,
 				domainName: rule.DomainName,
+				ruleType:   rule.RuleType,
+				status:     rule.Status,
 				vpcIds:     vpcAssociations[*rule.Id],
 			})
 		}
@@ -131,7 +135,10 @@ func (r *Route53ResolverRule) Remove() error {
 func (r *Route53ResolverRule) Properties() types.Properties {
 	return types.NewProperties().
 		Set("ID", r.id).
-		Set("Name", r.name)
+		Set("Name", r.name).
+		Set("DomainName", r.domainName).
+		Set("RuleType", r.ruleType).
+		Set("Status", r.status)
 }
 
 // String implements Stringer
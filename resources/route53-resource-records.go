@@ -18,7 +18,7 @@ type Route53ResourceRecordSet struct {
 }
 
 func init() {
-	register("Route53ResourceRecordSet", ListRoute53ResourceRecordSets)
+	registerGlobal("Route53ResourceRecordSet", ListRoute53ResourceRecordSets)
 }
 
 func ListRoute53ResourceRecordSets(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,7 @@ type Route53TrafficPolicy struct {
 }
 
 func init() {
-	register("Route53TrafficPolicy", ListRoute53TrafficPolicies)
+	registerGlobal("Route53TrafficPolicy", ListRoute53TrafficPolicies)
 }
 
 func ListRoute53TrafficPolicies(sess *session.Session) ([]Resource, error) {
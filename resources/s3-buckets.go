@@ -2,6 +2,7 @@ package resources
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -11,40 +12,52 @@ import (
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/aws/aws-sdk-go/service/s3/s3iface"
 	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
+	"golang.org/x/sync/errgroup"
 )
 
 func init() {
 	register("S3Bucket", ListS3Buckets)
 }
 
+// defaultS3DeleteConcurrency is the number of concurrent batch deletes of
+// object versions within a bucket, if the feature flag is not set.
+const defaultS3DeleteConcurrency = 4
+
 type S3Bucket struct {
-	svc  *s3.S3
-	name string
-	tags []*s3.Tag
+	svc          s3iface.S3API
+	region       *string
+	name         string
+	creationDate *time.Time
+	tags         []*s3.Tag
+
+	featureFlags config.FeatureFlags
 }
 
 func ListS3Buckets(s *session.Session) ([]Resource, error) {
 	svc := s3.New(s)
 
-	buckets, err := DescribeS3Buckets(svc)
+	buckets, err := describeS3Buckets(svc)
 	if err != nil {
 		return nil, err
 	}
 
 	resources := make([]Resource, 0)
-	for _, name := range buckets {
+	for _, bucket := range buckets {
 		tags, err := svc.GetBucketTagging(&s3.GetBucketTaggingInput{
-			Bucket: aws.String(name),
+			Bucket: bucket.Name,
 		})
 
 		if err != nil {
 			if aerr, ok := err.(awserr.Error); ok {
 				if aerr.Code() == "NoSuchTagSet" {
 					resources = append(resources, &S3Bucket{
-						svc: svc,
-						name: name,
-						tags: make([]*s3.Tag, 0),
+						svc:          svc,
+						region:       svc.Config.Region,
+						name:         aws.StringValue(bucket.Name),
+						creationDate: bucket.CreationDate,
+						tags:         make([]*s3.Tag, 0),
 					})
 				}
 			}
@@ -52,22 +65,40 @@ func ListS3Buckets(s *session.Session) ([]Resource, error) {
 		}
 
 		resources = append(resources, &S3Bucket{
-			svc:  svc,
-			name: name,
-			tags: tags.TagSet,
+			svc:          svc,
+			region:       svc.Config.Region,
+			name:         aws.StringValue(bucket.Name),
+			creationDate: bucket.CreationDate,
+			tags:         tags.TagSet,
 		})
 	}
 
 	return resources, nil
 }
 
+// DescribeS3Buckets returns the names of all buckets in the region of the
+// client.
 func DescribeS3Buckets(svc *s3.S3) ([]string, error) {
+	buckets, err := describeS3Buckets(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(buckets))
+	for _, bucket := range buckets {
+		names = append(names, aws.StringValue(bucket.Name))
+	}
+
+	return names, nil
+}
+
+func describeS3Buckets(svc *s3.S3) ([]*s3.Bucket, error) {
 	resp, err := svc.ListBuckets(nil)
 	if err != nil {
 		return nil, err
 	}
 
-	buckets := make([]string, 0)
+	buckets := make([]*s3.Bucket, 0)
 	for _, out := range resp.Buckets {
 		bucketLocationResponse, err := svc.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: out.Name})
 
@@ -78,7 +109,7 @@ func DescribeS3Buckets(svc *s3.S3) ([]string, error) {
 		location := UnPtrString(bucketLocationResponse.LocationConstraint, endpoints.UsEast1RegionID)
 		region := UnPtrString(svc.Config.Region, endpoints.UsEast1RegionID)
 		if location == region {
-			buckets = append(buckets, *out.Name)
+			buckets = append(buckets, out)
 		}
 
 	}
@@ -112,6 +143,11 @@ func (e *S3Bucket) Remove() error {
 		return err
 	}
 
+	err = e.AbortAllMultipartUploads()
+	if err != nil {
+		return err
+	}
+
 	_, err = e.svc.DeleteBucket(&s3.DeleteBucketInput{
 		Bucket: &e.name,
 	})
@@ -119,100 +155,170 @@ func (e *S3Bucket) Remove() error {
 	return err
 }
 
+func (e *S3Bucket) FeatureFlags(ff config.FeatureFlags) {
+	e.featureFlags = ff
+}
+
+// RemoveAllVersions deletes all object versions and delete markers. Every
+// listed page contains at most 1000 entries, which is also the limit of a
+// batch delete. The pages get deleted concurrently, while the next ones are
+// still being listed.
 func (e *S3Bucket) RemoveAllVersions() error {
-	params := &s3.ListObjectVersionsInput{
-		Bucket: &e.name,
+	g, ctx := errgroup.WithContext(aws.BackgroundContext())
+	batches := make(chan []*s3.ObjectIdentifier)
+
+	for i := 0; i < e.deleteConcurrency(); i++ {
+		g.Go(func() error {
+			for batch := range batches {
+				err := e.deleteObjects(ctx, batch)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
 	}
 
-	iterator := newS3DeleteVersionListIterator(e.svc, params)
-	return s3manager.NewBatchDeleteWithClient(e.svc).Delete(aws.BackgroundContext(), iterator)
-}
+	g.Go(func() error {
+		defer close(batches)
 
-func (e *S3Bucket) RemoveAllObjects() error {
-	params := &s3.ListObjectsInput{
-		Bucket: &e.name,
-	}
+		params := &s3.ListObjectVersionsInput{
+			Bucket: &e.name,
+		}
 
-	iterator := s3manager.NewDeleteListIterator(e.svc, params)
-	return s3manager.NewBatchDeleteWithClient(e.svc).Delete(aws.BackgroundContext(), iterator)
+		return e.svc.ListObjectVersionsPagesWithContext(ctx, params, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+			batch := make([]*s3.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
+			for _, version := range page.Versions {
+				batch = append(batch, &s3.ObjectIdentifier{Key: version.Key, VersionId: version.VersionId})
+			}
+			for _, marker := range page.DeleteMarkers {
+				batch = append(batch, &s3.ObjectIdentifier{Key: marker.Key, VersionId: marker.VersionId})
+			}
+			if len(batch) == 0 {
+				return true
+			}
+
+			select {
+			case batches <- batch:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+	})
+
+	return g.Wait()
 }
 
-func (e *S3Bucket) Properties() types.Properties {
-	properties := types.NewProperties()
-	properties.Set("Name", e.name)
+func (e *S3Bucket) deleteObjects(ctx aws.Context, objects []*s3.ObjectIdentifier) error {
+	resp, err := e.svc.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+		Bucket: &e.name,
+		Delete: &s3.Delete{
+			Objects: objects,
+			Quiet:   aws.Bool(true),
+		},
+		BypassGovernanceRetention: e.bypassGovernanceRetention(),
+	})
+	if err != nil {
+		return err
+	}
 
-	for _, tag := range e.tags {
-		properties.SetTag(tag.Key, tag.Value)
+	if len(resp.Errors) > 0 {
+		first := resp.Errors[0]
+		return fmt.Errorf("failed to delete %d objects, eg %s: %s: %s", len(resp.Errors),
+			aws.StringValue(first.Key), aws.StringValue(first.Code), aws.StringValue(first.Message))
 	}
 
-	return properties
+	return nil
 }
 
-func (e *S3Bucket) String() string {
-	return fmt.Sprintf("s3://%s", e.name)
+func (e *S3Bucket) deleteConcurrency() int {
+	if e.featureFlags.S3DeleteConcurrency > 0 {
+		return e.featureFlags.S3DeleteConcurrency
+	}
+	return defaultS3DeleteConcurrency
 }
 
-type s3DeleteVersionListIterator struct {
-	Bucket    *string
-	Paginator request.Pagination
-	objects   []*s3.ObjectVersion
+func (e *S3Bucket) bypassGovernanceRetention() *bool {
+	if e.featureFlags.BypassS3GovernanceRetention {
+		return aws.Bool(true)
+	}
+	return nil
 }
 
-func newS3DeleteVersionListIterator(svc s3iface.S3API, input *s3.ListObjectVersionsInput, opts ...func(*s3DeleteVersionListIterator)) s3manager.BatchDeleteIterator {
-	iter := &s3DeleteVersionListIterator{
-		Bucket: input.Bucket,
-		Paginator: request.Pagination{
-			NewRequest: func() (*request.Request, error) {
-				var inCpy *s3.ListObjectVersionsInput
-				if input != nil {
-					tmp := *input
-					inCpy = &tmp
-				}
-				req, _ := svc.ListObjectVersionsRequest(inCpy)
-				return req, nil
-			},
-		},
+func (e *S3Bucket) RemoveAllObjects() error {
+	params := &s3.ListObjectsInput{
+		Bucket: &e.name,
 	}
 
-	for _, opt := range opts {
-		opt(iter)
-	}
-	return iter
+	iterator := s3manager.NewDeleteListIterator(e.svc, params)
+	return e.batchDelete().Delete(aws.BackgroundContext(), iterator)
 }
 
-// Next will use the S3API client to iterate through a list of objects.
-func (iter *s3DeleteVersionListIterator) Next() bool {
-	if len(iter.objects) > 0 {
-		iter.objects = iter.objects[1:]
+// AbortAllMultipartUploads aborts the incomplete multipart uploads, whose
+// parts would otherwise remain after the objects got removed.
+func (e *S3Bucket) AbortAllMultipartUploads() error {
+	params := &s3.ListMultipartUploadsInput{
+		Bucket: &e.name,
 	}
 
-	if len(iter.objects) == 0 && iter.Paginator.Next() {
-		output := iter.Paginator.Page().(*s3.ListObjectVersionsOutput)
-		iter.objects = output.Versions
-
-		for _, entry := range output.DeleteMarkers {
-			iter.objects = append(iter.objects, &s3.ObjectVersion{
-				Key:       entry.Key,
-				VersionId: entry.VersionId,
+	var abortErr error
+	err := e.svc.ListMultipartUploadsPages(params, func(page *s3.ListMultipartUploadsOutput, lastPage bool) bool {
+		for _, upload := range page.Uploads {
+			_, abortErr = e.svc.AbortMultipartUpload(&s3.AbortMultipartUploadInput{
+				Bucket:   &e.name,
+				Key:      upload.Key,
+				UploadId: upload.UploadId,
 			})
+			if abortErr != nil {
+				return false
+			}
 		}
+		return true
+	})
+	if err != nil {
+		return err
 	}
 
-	return len(iter.objects) > 0
+	return abortErr
 }
 
-// Err will return the last known error from Next.
-func (iter *s3DeleteVersionListIterator) Err() error {
-	return iter.Paginator.Err()
+// batchDelete returns a batch delete, which bypasses the governance mode
+// retention of object lock, if the feature flag allows it. Objects in
+// compliance mode or with a legal hold still cannot be removed.
+func (e *S3Bucket) batchDelete() *s3manager.BatchDelete {
+	var client s3iface.S3API = e.svc
+	if e.featureFlags.BypassS3GovernanceRetention {
+		client = s3BypassGovernanceClient{S3API: e.svc}
+	}
+	return s3manager.NewBatchDeleteWithClient(client)
 }
 
-// DeleteObject will return the current object to be deleted.
-func (iter *s3DeleteVersionListIterator) DeleteObject() s3manager.BatchDeleteObject {
-	return s3manager.BatchDeleteObject{
-		Object: &s3.DeleteObjectInput{
-			Bucket:    iter.Bucket,
-			Key:       iter.objects[0].Key,
-			VersionId: iter.objects[0].VersionId,
-		},
+// s3BypassGovernanceClient sets BypassGovernanceRetention on all batch
+// deletes, since the BatchDelete of the SDK does not pass it on from the
+// single objects.
+type s3BypassGovernanceClient struct {
+	s3iface.S3API
+}
+
+func (c s3BypassGovernanceClient) DeleteObjectsWithContext(ctx aws.Context, input *s3.DeleteObjectsInput, opts ...request.Option) (*s3.DeleteObjectsOutput, error) {
+	input.BypassGovernanceRetention = aws.Bool(true)
+	return c.S3API.DeleteObjectsWithContext(ctx, input, opts...)
+}
+
+func (e *S3Bucket) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("Name", e.name)
+	properties.Set("Region", e.region)
+	properties.Set("CreationDate", e.creationDate)
+
+	for _, tag := range e.tags {
+		properties.SetTag(tag.Key, tag.Value)
 	}
+
+	return properties
+}
+
+func (e *S3Bucket) String() string {
+	return fmt.Sprintf("s3://%s", e.name)
 }
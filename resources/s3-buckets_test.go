@@ -0,0 +1,99 @@
+package resources
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type s3DeleteObjectsRecorder struct {
+	s3iface.S3API
+
+	pages []*s3.ListObjectVersionsOutput
+
+	lock   sync.Mutex
+	inputs []*s3.DeleteObjectsInput
+}
+
+func (r *s3DeleteObjectsRecorder) DeleteObjectsWithContext(ctx aws.Context, input *s3.DeleteObjectsInput, opts ...request.Option) (*s3.DeleteObjectsOutput, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.inputs = append(r.inputs, input)
+	return &s3.DeleteObjectsOutput{}, nil
+}
+
+func (r *s3DeleteObjectsRecorder) ListObjectVersionsPagesWithContext(ctx aws.Context, input *s3.ListObjectVersionsInput, fn func(*s3.ListObjectVersionsOutput, bool) bool, opts ...request.Option) error {
+	for i, page := range r.pages {
+		if !fn(page, i == len(r.pages)-1) {
+			break
+		}
+	}
+	return nil
+}
+
+func TestS3BucketRemoveAllVersions(t *testing.T) {
+	a := assert.New(t)
+
+	recorder := &s3DeleteObjectsRecorder{}
+	for p := 0; p < 3; p++ {
+		page := &s3.ListObjectVersionsOutput{}
+		for i := 0; i < 1000; i++ {
+			key := aws.String(fmt.Sprintf("%d-%d", p, i))
+			if i%2 == 0 {
+				page.Versions = append(page.Versions, &s3.ObjectVersion{Key: key, VersionId: aws.String("v1")})
+			} else {
+				page.DeleteMarkers = append(page.DeleteMarkers, &s3.DeleteMarkerEntry{Key: key, VersionId: aws.String("v2")})
+			}
+		}
+		recorder.pages = append(recorder.pages, page)
+	}
+	recorder.pages = append(recorder.pages, &s3.ListObjectVersionsOutput{})
+
+	bucket := &S3Bucket{svc: recorder, name: "foo"}
+	bucket.FeatureFlags(config.FeatureFlags{
+		S3DeleteConcurrency:         2,
+		BypassS3GovernanceRetention: true,
+	})
+
+	a.NoError(bucket.RemoveAllVersions())
+
+	a.Len(recorder.inputs, 3)
+	deleted := map[string]bool{}
+	for _, input := range recorder.inputs {
+		a.Equal("foo", aws.StringValue(input.Bucket))
+		a.True(aws.BoolValue(input.BypassGovernanceRetention))
+		a.Len(input.Delete.Objects, 1000)
+		for _, o := range input.Delete.Objects {
+			deleted[aws.StringValue(o.Key)] = true
+		}
+	}
+	a.Len(deleted, 3000)
+}
+
+func TestS3BypassGovernanceClient(t *testing.T) {
+	a := assert.New(t)
+
+	recorder := &s3DeleteObjectsRecorder{}
+	batch := s3manager.NewBatchDeleteWithClient(s3BypassGovernanceClient{S3API: recorder})
+
+	err := batch.Delete(aws.BackgroundContext(), &s3manager.DeleteObjectsIterator{
+		Objects: []s3manager.BatchDeleteObject{
+			{Object: &s3.DeleteObjectInput{Bucket: aws.String("foo"), Key: aws.String("a")}},
+			{Object: &s3.DeleteObjectInput{Bucket: aws.String("foo"), Key: aws.String("b")}},
+		},
+	})
+	a.NoError(err)
+
+	a.Len(recorder.inputs, 1)
+	a.True(aws.BoolValue(recorder.inputs[0].BypassGovernanceRetention))
+	a.Len(recorder.inputs[0].Delete.Objects, 2)
+}
@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type SageMakerEndpointConfig struct {
 	svc                *sagemaker.SageMaker
 	endpointConfigName *string
+	creationTime       *time.Time
+	tags               []*sagemaker.Tag
 }
 
 func init() {
@@ -30,9 +35,16 @@ func ListSageMakerEndpointConfigs(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, endpointConfig := range resp.EndpointConfigs {
+			tags, err := listSageMakerTags(svc, endpointConfig.EndpointConfigArn)
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &SageMakerEndpointConfig{
 				svc:                svc,
 				endpointConfigName: endpointConfig.EndpointConfigName,
+				creationTime:       endpointConfig.CreationTime,
+				tags:               tags,
 			})
 		}
 
@@ -58,3 +70,14 @@ func (f *SageMakerEndpointConfig) Remove() error {
 func (f *SageMakerEndpointConfig) String() string {
 	return *f.endpointConfigName
 }
+
+func (f *SageMakerEndpointConfig) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.endpointConfigName).
+		Set("CreationTime", f.creationTime)
+	return properties
+}
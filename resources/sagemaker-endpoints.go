@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type SageMakerEndpoint struct {
 	svc          *sagemaker.SageMaker
 	endpointName *string
+	creationTime *time.Time
+	tags         []*sagemaker.Tag
 }
 
 func init() {
@@ -30,9 +35,16 @@ func ListSageMakerEndpoints(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, endpoint := range resp.Endpoints {
+			tags, err := listSageMakerTags(svc, endpoint.EndpointArn)
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &SageMakerEndpoint{
 				svc:          svc,
 				endpointName: endpoint.EndpointName,
+				creationTime: endpoint.CreationTime,
+				tags:         tags,
 			})
 		}
 
@@ -58,3 +70,40 @@ func (f *SageMakerEndpoint) Remove() error {
 func (f *SageMakerEndpoint) String() string {
 	return *f.endpointName
 }
+
+func (f *SageMakerEndpoint) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.endpointName).
+		Set("CreationTime", f.creationTime)
+	return properties
+}
+
+func listSageMakerTags(svc *sagemaker.SageMaker, arn *string) ([]*sagemaker.Tag, error) {
+	tags := []*sagemaker.Tag{}
+
+	params := &sagemaker.ListTagsInput{
+		ResourceArn: arn,
+		MaxResults:  aws.Int64(100),
+	}
+
+	for {
+		resp, err := svc.ListTags(params)
+		if err != nil {
+			return nil, err
+		}
+
+		tags = append(tags, resp.Tags...)
+
+		if resp.NextToken == nil {
+			break
+		}
+
+		params.NextToken = resp.NextToken
+	}
+
+	return tags, nil
+}
@@ -1,14 +1,19 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type SageMakerModel struct {
-	svc       *sagemaker.SageMaker
-	modelName *string
+	svc          *sagemaker.SageMaker
+	modelName    *string
+	creationTime *time.Time
+	tags         []*sagemaker.Tag
 }
 
 func init() {
@@ -30,9 +35,16 @@ func ListSageMakerModels(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, model := range resp.Models {
+			tags, err := listSageMakerTags(svc, model.ModelArn)
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &SageMakerModel{
-				svc:       svc,
-				modelName: model.ModelName,
+				svc:          svc,
+				modelName:    model.ModelName,
+				creationTime: model.CreationTime,
+				tags:         tags,
 			})
 		}
 
@@ -58,3 +70,14 @@ func (f *SageMakerModel) Remove() error {
 func (f *SageMakerModel) String() string {
 	return *f.modelName
 }
+
+func (f *SageMakerModel) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.modelName).
+		Set("CreationTime", f.creationTime)
+	return properties
+}
@@ -65,8 +65,11 @@ func (f *SageMakerNotebookInstanceState) String() string {
 }
 
 func (f *SageMakerNotebookInstanceState) Filter() error {
-	if strings.ToLower(*f.instanceStatus) == "stopped" {
+	switch strings.ToLower(*f.instanceStatus) {
+	case "stopped", "stopping":
 		return fmt.Errorf("already stopped")
+	case "failed", "deleting":
+		return fmt.Errorf("cannot be stopped")
 	}
 	return nil
 }
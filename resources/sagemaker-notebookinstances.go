@@ -1,14 +1,20 @@
 package resources
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type SageMakerNotebookInstance struct {
 	svc                  *sagemaker.SageMaker
 	notebookInstanceName *string
+	creationTime         *time.Time
+	tags                 []*sagemaker.Tag
 }
 
 func init() {
@@ -30,9 +36,16 @@ func ListSageMakerNotebookInstances(sess *session.Session) ([]Resource, error) {
 		}
 
 		for _, notebookInstance := range resp.NotebookInstances {
+			tags, err := listSageMakerTags(svc, notebookInstance.NotebookInstanceArn)
+			if err != nil {
+				return nil, err
+			}
+
 			resources = append(resources, &SageMakerNotebookInstance{
 				svc:                  svc,
 				notebookInstanceName: notebookInstance.NotebookInstanceName,
+				creationTime:         notebookInstance.CreationTime,
+				tags:                 tags,
 			})
 		}
 
@@ -46,8 +59,38 @@ func ListSageMakerNotebookInstances(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove stops the notebook instance first, because only stopped or failed
+// instances can be deleted. Until the instance is stopped, an error is
+// returned, so the removal gets retried on the next pass over the queue.
 func (f *SageMakerNotebookInstance) Remove() error {
-	_, err := f.svc.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
+	resp, err := f.svc.DescribeNotebookInstance(&sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: f.notebookInstanceName,
+	})
+	if err != nil {
+		return err
+	}
+
+	switch status := aws.StringValue(resp.NotebookInstanceStatus); status {
+	case sagemaker.NotebookInstanceStatusDeleting:
+		return nil
+
+	case sagemaker.NotebookInstanceStatusInService:
+		_, err := f.svc.StopNotebookInstance(&sagemaker.StopNotebookInstanceInput{
+			NotebookInstanceName: f.notebookInstanceName,
+		})
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("stopping notebook instance before deletion")
+
+	case sagemaker.NotebookInstanceStatusStopped, sagemaker.NotebookInstanceStatusFailed:
+		// The instance can be deleted.
+
+	default:
+		return fmt.Errorf("waiting for notebook instance in state %s", status)
+	}
+
+	_, err = f.svc.DeleteNotebookInstance(&sagemaker.DeleteNotebookInstanceInput{
 		NotebookInstanceName: f.notebookInstanceName,
 	})
 
@@ -57,3 +100,14 @@ func (f *SageMakerNotebookInstance) Remove() error {
 func (f *SageMakerNotebookInstance) String() string {
 	return *f.notebookInstanceName
 }
+
+func (f *SageMakerNotebookInstance) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.notebookInstanceName).
+		Set("CreationTime", f.creationTime)
+	return properties
+}
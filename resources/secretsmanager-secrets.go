@@ -1,16 +1,23 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/rebuy-de/aws-nuke/pkg/config"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type SecretsManagerSecret struct {
-	svc  *secretsmanager.SecretsManager
-	ARN  *string
-	tags []*secretsmanager.Tag
+	svc             *secretsmanager.SecretsManager
+	ARN             *string
+	name            *string
+	lastChangedDate *time.Time
+	tags            []*secretsmanager.Tag
+
+	featureFlags config.FeatureFlags
 }
 
 func init() {
@@ -33,9 +40,11 @@ func ListSecretsManagerSecrets(sess *session.Session) ([]Resource, error) {
 
 		for _, secrets := range output.SecretList {
 			resources = append(resources, &SecretsManagerSecret{
-				svc:  svc,
-				ARN:  secrets.ARN,
-				tags: secrets.Tags,
+				svc:             svc,
+				ARN:             secrets.ARN,
+				name:            secrets.Name,
+				lastChangedDate: secrets.LastChangedDate,
+				tags:            secrets.Tags,
 			})
 		}
 
@@ -49,11 +58,17 @@ func ListSecretsManagerSecrets(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
-func (f *SecretsManagerSecret) Remove() error {
+func (f *SecretsManagerSecret) FeatureFlags(ff config.FeatureFlags) {
+	f.featureFlags = ff
+}
 
+// Remove schedules the deletion of the secret with the default recovery
+// window, unless the feature flag force-delete-secrets is set. Secrets, which
+// are scheduled for deletion, are not listed anymore.
+func (f *SecretsManagerSecret) Remove() error {
 	_, err := f.svc.DeleteSecret(&secretsmanager.DeleteSecretInput{
 		SecretId:                   f.ARN,
-		ForceDeleteWithoutRecovery: aws.Bool(true),
+		ForceDeleteWithoutRecovery: aws.Bool(f.featureFlags.ForceDeleteSecrets),
 	})
 
 	return err
@@ -64,6 +79,9 @@ func (f *SecretsManagerSecret) Properties() types.Properties {
 	for _, tagValue := range f.tags {
 		properties.SetTag(tagValue.Key, tagValue.Value)
 	}
+	properties.Set("Name", f.name)
+	properties.Set("ARN", f.ARN)
+	properties.Set("LastChangedDate", f.lastChangedDate)
 	return properties
 }
 
@@ -1,6 +1,9 @@
 package resources
 
 import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/securityhub"
 	"github.com/rebuy-de/aws-nuke/pkg/types"
@@ -26,23 +29,43 @@ func ListHubs(sess *session.Session) ([]Resource, error) {
 	}
 
 	resources = append(resources, &Hub{
-		svc: svc,
-		id:  resp.HubArn,
+		svc:          svc,
+		id:           resp.HubArn,
+		subscribedAt: resp.SubscribedAt,
 	})
 	return resources, nil
 }
 
 type Hub struct {
-	svc *securityhub.SecurityHub
-	id  *string
+	svc          *securityhub.SecurityHub
+	id           *string
+	subscribedAt *string
 }
 
 func (hub *Hub) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("Arn", hub.id)
+	properties.Set("SubscribedAt", hub.subscribedAt)
 	return properties
 }
 
+// Filter protects the hub of administrator accounts, because disabling it
+// would also affect all member accounts of the organization.
+func (hub *Hub) Filter() error {
+	resp, err := hub.svc.ListMembers(&securityhub.ListMembersInput{
+		MaxResults: aws.Int64(1),
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Members) > 0 {
+		return fmt.Errorf("hub of an administrator account with members")
+	}
+
+	return nil
+}
+
 func (hub *Hub) Remove() error {
 	_, err := hub.svc.DisableSecurityHub(&securityhub.DisableSecurityHubInput{})
 	return err
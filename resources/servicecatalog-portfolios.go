@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
@@ -12,6 +14,7 @@ type ServiceCatalogPortfolio struct {
 	ID           *string
 	displayName  *string
 	providerName *string
+	createdTime  *time.Time
 }
 
 func init() {
@@ -38,6 +41,7 @@ func ListServiceCatalogPortfolios(sess *session.Session) ([]Resource, error) {
 				ID:           portfolioDetail.Id,
 				displayName:  portfolioDetail.DisplayName,
 				providerName: portfolioDetail.ProviderName,
+				createdTime:  portfolioDetail.CreatedTime,
 			})
 		}
 
@@ -51,6 +55,10 @@ func ListServiceCatalogPortfolios(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove fails as long as products, constraints, principals or shares are
+// associated with the portfolio. These are removed by the ServiceCatalog
+// attachment resources (eg ServiceCatalogPortfolioProductAttachment) and the
+// deletion gets retried.
 func (f *ServiceCatalogPortfolio) Remove() error {
 
 	_, err := f.svc.DeletePortfolio(&servicecatalog.DeletePortfolioInput{
@@ -65,6 +73,7 @@ func (f *ServiceCatalogPortfolio) Properties() types.Properties {
 	properties.Set("ID", f.ID)
 	properties.Set("DisplayName", f.displayName)
 	properties.Set("ProviderName", f.providerName)
+	properties.Set("CreatedTime", f.createdTime)
 	return properties
 }
 
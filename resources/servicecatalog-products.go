@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
@@ -8,9 +10,10 @@ import (
 )
 
 type ServiceCatalogProduct struct {
-	svc  *servicecatalog.ServiceCatalog
-	ID   *string
-	name *string
+	svc         *servicecatalog.ServiceCatalog
+	ID          *string
+	name        *string
+	createdTime *time.Time
 }
 
 func init() {
@@ -33,9 +36,10 @@ func ListServiceCatalogProducts(sess *session.Session) ([]Resource, error) {
 
 		for _, productView := range resp.ProductViewDetails {
 			resources = append(resources, &ServiceCatalogProduct{
-				svc:  svc,
-				ID:   productView.ProductViewSummary.ProductId,
-				name: productView.ProductViewSummary.Name,
+				svc:         svc,
+				ID:          productView.ProductViewSummary.ProductId,
+				name:        productView.ProductViewSummary.Name,
+				createdTime: productView.CreatedTime,
 			})
 		}
 
@@ -49,6 +53,10 @@ func ListServiceCatalogProducts(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove fails as long as the product is associated with a portfolio or has
+// provisioned products. These are removed by
+// ServiceCatalogPortfolioProductAttachment and
+// ServiceCatalogProvisionedProduct and the deletion gets retried.
 func (f *ServiceCatalogProduct) Remove() error {
 
 	_, err := f.svc.DeleteProduct(&servicecatalog.DeleteProductInput{
@@ -62,6 +70,7 @@ func (f *ServiceCatalogProduct) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("ID", f.ID)
 	properties.Set("Name", f.name)
+	properties.Set("CreatedTime", f.createdTime)
 	return properties
 }
 
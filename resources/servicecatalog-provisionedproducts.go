@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/servicecatalog"
@@ -13,6 +15,7 @@ type ServiceCatalogProvisionedProduct struct {
 	terminateToken *string
 	name           *string
 	productID      *string
+	createdTime    *time.Time
 }
 
 func init() {
@@ -44,6 +47,7 @@ func ListServiceCatalogProvisionedProducts(sess *session.Session) ([]Resource, e
 				terminateToken: provisionedProduct.IdempotencyToken,
 				name:           provisionedProduct.Name,
 				productID:      provisionedProduct.ProductId,
+				createdTime:    provisionedProduct.CreatedTime,
 			})
 		}
 
@@ -73,6 +77,7 @@ func (f *ServiceCatalogProvisionedProduct) Properties() types.Properties {
 	properties.Set("ID", f.ID)
 	properties.Set("Name", f.name)
 	properties.Set("ProductID", f.productID)
+	properties.Set("CreatedTime", f.createdTime)
 	return properties
 }
 
@@ -1,14 +1,20 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sfn"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type SFNStateMachine struct {
-	svc *sfn.SFN
-	ARN *string
+	svc          *sfn.SFN
+	ARN          *string
+	name         *string
+	machineType  *string
+	creationDate *time.Time
 }
 
 func init() {
@@ -31,8 +37,11 @@ func ListSFNStateMachines(sess *session.Session) ([]Resource, error) {
 
 		for _, stateMachine := range output.StateMachines {
 			resources = append(resources, &SFNStateMachine{
-				svc: svc,
-				ARN: stateMachine.StateMachineArn,
+				svc:          svc,
+				ARN:          stateMachine.StateMachineArn,
+				name:         stateMachine.Name,
+				machineType:  stateMachine.Type,
+				creationDate: stateMachine.CreationDate,
 			})
 		}
 
@@ -46,7 +55,37 @@ func ListSFNStateMachines(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove stops all running executions before deleting the state machine.
+// Executions, which get started in the meantime, are stopped on the next
+// retry.
 func (f *SFNStateMachine) Remove() error {
+	params := &sfn.ListExecutionsInput{
+		StateMachineArn: f.ARN,
+		StatusFilter:    aws.String(sfn.ExecutionStatusRunning),
+		MaxResults:      aws.Int64(100),
+	}
+
+	for {
+		output, err := f.svc.ListExecutions(params)
+		if err != nil {
+			return err
+		}
+
+		for _, execution := range output.Executions {
+			_, err := f.svc.StopExecution(&sfn.StopExecutionInput{
+				ExecutionArn: execution.ExecutionArn,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
 
 	_, err := f.svc.DeleteStateMachine(&sfn.DeleteStateMachineInput{
 		StateMachineArn: f.ARN,
@@ -58,3 +97,10 @@ func (f *SFNStateMachine) Remove() error {
 func (f *SFNStateMachine) String() string {
 	return *f.ARN
 }
+
+func (f *SFNStateMachine) Properties() types.Properties {
+	return types.NewProperties().
+		Set("Name", f.name).
+		Set("Type", f.machineType).
+		Set("CreationDate", f.creationDate)
+}
@@ -4,11 +4,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type SSMDocument struct {
-	svc  *ssm.SSM
-	name *string
+	svc          *ssm.SSM
+	name         *string
+	documentType *string
+	tags         []*ssm.Tag
 }
 
 func init() {
@@ -19,6 +22,7 @@ func ListSSMDocuments(sess *session.Session) ([]Resource, error) {
 	svc := ssm.New(sess)
 	resources := []Resource{}
 
+	// Documents owned by AWS or shared by other accounts cannot be deleted.
 	documentKeyFilter := []*ssm.DocumentKeyValuesFilter{
 		{
 			Key:    aws.String("Owner"),
@@ -39,8 +43,10 @@ func ListSSMDocuments(sess *session.Session) ([]Resource, error) {
 
 		for _, documentIdentifier := range output.DocumentIdentifiers {
 			resources = append(resources, &SSMDocument{
-				svc:  svc,
-				name: documentIdentifier.Name,
+				svc:          svc,
+				name:         documentIdentifier.Name,
+				documentType: documentIdentifier.DocumentType,
+				tags:         documentIdentifier.Tags,
 			})
 		}
 
@@ -66,3 +72,14 @@ func (f *SSMDocument) Remove() error {
 func (f *SSMDocument) String() string {
 	return *f.name
 }
+
+func (f *SSMDocument) Properties() types.Properties {
+	properties := types.NewProperties()
+	for _, tag := range f.tags {
+		properties.SetTag(tag.Key, tag.Value)
+	}
+	properties.
+		Set("Name", f.name).
+		Set("Type", f.documentType)
+	return properties
+}
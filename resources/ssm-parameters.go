@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ssm"
@@ -8,9 +10,11 @@ import (
 )
 
 type SSMParameter struct {
-	svc  *ssm.SSM
-	name *string
-	tags []*ssm.Tag
+	svc              *ssm.SSM
+	name             *string
+	parameterType    *string
+	lastModifiedDate *time.Time
+	tags             []*ssm.Tag
 }
 
 func init() {
@@ -43,9 +47,11 @@ func ListSSMParameters(sess *session.Session) ([]Resource, error) {
 			}
 
 			resources = append(resources, &SSMParameter{
-				svc:  svc,
-				name: parameter.Name,
-				tags: tagResp.TagList,
+				svc:              svc,
+				name:             parameter.Name,
+				parameterType:    parameter.Type,
+				lastModifiedDate: parameter.LastModifiedDate,
+				tags:             tagResp.TagList,
 			})
 		}
 
@@ -78,6 +84,8 @@ func (f *SSMParameter) Properties() types.Properties {
 		properties.SetTag(tag.Key, tag.Value)
 	}
 	properties.
-		Set("Name", f.name)
+		Set("Name", f.name).
+		Set("Type", f.parameterType).
+		Set("LastModifiedDate", f.lastModifiedDate)
 	return properties
 }
@@ -0,0 +1,77 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type SSMSession struct {
+	svc       *ssm.SSM
+	sessionID *string
+	target    *string
+	owner     *string
+	startDate *time.Time
+}
+
+func init() {
+	register("SSMSession", ListSSMSessions)
+}
+
+func ListSSMSessions(sess *session.Session) ([]Resource, error) {
+	svc := ssm.New(sess)
+	resources := []Resource{}
+
+	params := &ssm.DescribeSessionsInput{
+		State:      aws.String(ssm.SessionStateActive),
+		MaxResults: aws.Int64(200),
+	}
+
+	for {
+		output, err := svc.DescribeSessions(params)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, s := range output.Sessions {
+			resources = append(resources, &SSMSession{
+				svc:       svc,
+				sessionID: s.SessionId,
+				target:    s.Target,
+				owner:     s.Owner,
+				startDate: s.StartDate,
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return resources, nil
+}
+
+func (f *SSMSession) Remove() error {
+	_, err := f.svc.TerminateSession(&ssm.TerminateSessionInput{
+		SessionId: f.sessionID,
+	})
+
+	return err
+}
+
+func (f *SSMSession) String() string {
+	return *f.sessionID
+}
+
+func (f *SSMSession) Properties() types.Properties {
+	return types.NewProperties().
+		Set("SessionID", f.sessionID).
+		Set("Target", f.target).
+		Set("Owner", f.owner).
+		Set("StartDate", f.startDate)
+}
@@ -4,11 +4,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type StorageGatewayFileShare struct {
-	svc *storagegateway.StorageGateway
-	ARN *string
+	svc        *storagegateway.StorageGateway
+	ARN        *string
+	gatewayARN *string
+	shareType  *string
+	status     *string
 }
 
 func init() {
@@ -31,8 +35,11 @@ func ListStorageGatewayFileShares(sess *session.Session) ([]Resource, error) {
 
 		for _, fileShareInfo := range output.FileShareInfoList {
 			resources = append(resources, &StorageGatewayFileShare{
-				svc: svc,
-				ARN: fileShareInfo.FileShareARN,
+				svc:        svc,
+				ARN:        fileShareInfo.FileShareARN,
+				gatewayARN: fileShareInfo.GatewayARN,
+				shareType:  fileShareInfo.FileShareType,
+				status:     fileShareInfo.FileShareStatus,
 			})
 		}
 
@@ -47,6 +54,9 @@ func ListStorageGatewayFileShares(sess *session.Session) ([]Resource, error) {
 }
 
 func (f *StorageGatewayFileShare) Remove() error {
+	if aws.StringValue(f.status) == "DELETING" {
+		return nil
+	}
 
 	_, err := f.svc.DeleteFileShare(&storagegateway.DeleteFileShareInput{
 		FileShareARN: f.ARN,
@@ -56,6 +66,14 @@ func (f *StorageGatewayFileShare) Remove() error {
 	return err
 }
 
+func (f *StorageGatewayFileShare) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ARN", f.ARN).
+		Set("GatewayARN", f.gatewayARN).
+		Set("Type", f.shareType).
+		Set("Status", f.status)
+}
+
 func (f *StorageGatewayFileShare) String() string {
 	return *f.ARN
 }
@@ -4,11 +4,15 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type StorageGatewayGateway struct {
-	svc *storagegateway.StorageGateway
-	ARN *string
+	svc         *storagegateway.StorageGateway
+	ARN         *string
+	name        *string
+	gatewayType *string
+	state       *string
 }
 
 func init() {
@@ -31,8 +35,11 @@ func ListStorageGatewayGateways(sess *session.Session) ([]Resource, error) {
 
 		for _, gateway := range output.Gateways {
 			resources = append(resources, &StorageGatewayGateway{
-				svc: svc,
-				ARN: gateway.GatewayARN,
+				svc:         svc,
+				ARN:         gateway.GatewayARN,
+				name:        gateway.GatewayName,
+				gatewayType: gateway.GatewayType,
+				state:       gateway.GatewayOperationalState,
 			})
 		}
 
@@ -46,6 +53,9 @@ func ListStorageGatewayGateways(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the gateway. The file shares and volumes of the gateway
+// have a higher deletion priority, because they cannot be deleted properly
+// once the gateway is gone.
 func (f *StorageGatewayGateway) Remove() error {
 
 	_, err := f.svc.DeleteGateway(&storagegateway.DeleteGatewayInput{
@@ -55,6 +65,14 @@ func (f *StorageGatewayGateway) Remove() error {
 	return err
 }
 
+func (f *StorageGatewayGateway) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ARN", f.ARN).
+		Set("Name", f.name).
+		Set("Type", f.gatewayType).
+		Set("State", f.state)
+}
+
 func (f *StorageGatewayGateway) String() string {
 	return *f.ARN
 }
@@ -4,11 +4,14 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/storagegateway"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type StorageGatewayVolume struct {
-	svc *storagegateway.StorageGateway
-	ARN *string
+	svc        *storagegateway.StorageGateway
+	ARN        *string
+	gatewayARN *string
+	volumeType *string
 }
 
 func init() {
@@ -31,8 +34,10 @@ func ListStorageGatewayVolumes(sess *session.Session) ([]Resource, error) {
 
 		for _, volumeInfo := range output.VolumeInfos {
 			resources = append(resources, &StorageGatewayVolume{
-				svc: svc,
-				ARN: volumeInfo.VolumeARN,
+				svc:        svc,
+				ARN:        volumeInfo.VolumeARN,
+				gatewayARN: volumeInfo.GatewayARN,
+				volumeType: volumeInfo.VolumeType,
 			})
 		}
 
@@ -55,6 +60,13 @@ func (f *StorageGatewayVolume) Remove() error {
 	return err
 }
 
+func (f *StorageGatewayVolume) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ARN", f.ARN).
+		Set("GatewayARN", f.gatewayARN).
+		Set("Type", f.volumeType)
+}
+
 func (f *StorageGatewayVolume) String() string {
 	return *f.ARN
 }
@@ -0,0 +1,91 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type TimestreamDatabase struct {
+	svc          *timestreamwrite.TimestreamWrite
+	name         *string
+	tableCount   *int64
+	creationTime *time.Time
+}
+
+func init() {
+	register("TimestreamDatabase", ListTimestreamDatabases)
+}
+
+func ListTimestreamDatabases(sess *session.Session) ([]Resource, error) {
+	svc := timestreamwrite.New(sess)
+	resources := []Resource{}
+
+	databases, err := listTimestreamDatabases(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, database := range databases {
+		resources = append(resources, &TimestreamDatabase{
+			svc:          svc,
+			name:         database.DatabaseName,
+			tableCount:   database.TableCount,
+			creationTime: database.CreationTime,
+		})
+	}
+
+	return resources, nil
+}
+
+func listTimestreamDatabases(svc *timestreamwrite.TimestreamWrite) ([]*timestreamwrite.Database, error) {
+	databases := []*timestreamwrite.Database{}
+
+	params := &timestreamwrite.ListDatabasesInput{
+		MaxResults: aws.Int64(20),
+	}
+
+	for {
+		output, err := svc.ListDatabases(params)
+		if err != nil {
+			return nil, err
+		}
+
+		databases = append(databases, output.Databases...)
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
+
+	return databases, nil
+}
+
+// Remove fails as long as the database contains tables and gets retried
+// until all TimestreamTables are deleted.
+func (f *TimestreamDatabase) Remove() error {
+	_, err := f.svc.DeleteDatabase(&timestreamwrite.DeleteDatabaseInput{
+		DatabaseName: f.name,
+	})
+
+	return err
+}
+
+func (f *TimestreamDatabase) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("Name", f.name)
+	properties.Set("TableCount", f.tableCount)
+	if f.creationTime != nil {
+		properties.Set("CreationTime", f.creationTime.Format(time.RFC3339))
+	}
+	return properties
+}
+
+func (f *TimestreamDatabase) String() string {
+	return *f.name
+}
@@ -0,0 +1,92 @@
+package resources
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/timestreamwrite"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
+)
+
+type TimestreamTable struct {
+	svc          *timestreamwrite.TimestreamWrite
+	name         *string
+	databaseName *string
+	status       *string
+	creationTime *time.Time
+}
+
+func init() {
+	register("TimestreamTable", ListTimestreamTables)
+}
+
+func ListTimestreamTables(sess *session.Session) ([]Resource, error) {
+	svc := timestreamwrite.New(sess)
+	resources := []Resource{}
+
+	databases, err := listTimestreamDatabases(svc)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, database := range databases {
+		params := &timestreamwrite.ListTablesInput{
+			DatabaseName: database.DatabaseName,
+			MaxResults:   aws.Int64(20),
+		}
+
+		for {
+			output, err := svc.ListTables(params)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, table := range output.Tables {
+				resources = append(resources, &TimestreamTable{
+					svc:          svc,
+					name:         table.TableName,
+					databaseName: table.DatabaseName,
+					status:       table.TableStatus,
+					creationTime: table.CreationTime,
+				})
+			}
+
+			if output.NextToken == nil {
+				break
+			}
+
+			params.NextToken = output.NextToken
+		}
+	}
+
+	return resources, nil
+}
+
+func (f *TimestreamTable) Remove() error {
+	if aws.StringValue(f.status) == timestreamwrite.TableStatusDeleting {
+		return nil
+	}
+
+	_, err := f.svc.DeleteTable(&timestreamwrite.DeleteTableInput{
+		DatabaseName: f.databaseName,
+		TableName:    f.name,
+	})
+
+	return err
+}
+
+func (f *TimestreamTable) Properties() types.Properties {
+	properties := types.NewProperties()
+	properties.Set("Name", f.name)
+	properties.Set("DatabaseName", f.databaseName)
+	properties.Set("Status", f.status)
+	if f.creationTime != nil {
+		properties.Set("CreationTime", f.creationTime.Format(time.RFC3339))
+	}
+	return properties
+}
+
+func (f *TimestreamTable) String() string {
+	return *f.databaseName + " -> " + *f.name
+}
@@ -13,6 +13,7 @@ type TransferServer struct {
 	svc          *transfer.Transfer
 	serverID     *string
 	endpointType *string
+	state        *string
 	protocols    []string
 	tags         []*transfer.Tag
 }
@@ -52,6 +53,7 @@ func ListTransferServers(sess *session.Session) ([]Resource, error) {
 				svc:          svc,
 				serverID:     item.ServerId,
 				endpointType: item.EndpointType,
+				state:        item.State,
 				protocols:    protocols,
 				tags:         descOutput.Server.Tags,
 			})
@@ -67,7 +69,37 @@ func ListTransferServers(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+// Remove deletes the users of the server first, so the deletion does not
+// depend on the TransferServerUser resources. The server does not need to be
+// stopped before it gets deleted.
 func (ts *TransferServer) Remove() error {
+	params := &transfer.ListUsersInput{
+		MaxResults: aws.Int64(100),
+		ServerId:   ts.serverID,
+	}
+
+	for {
+		output, err := ts.svc.ListUsers(params)
+		if err != nil {
+			return err
+		}
+
+		for _, user := range output.Users {
+			_, err := ts.svc.DeleteUser(&transfer.DeleteUserInput{
+				ServerId: ts.serverID,
+				UserName: user.UserName,
+			})
+			if err != nil {
+				return err
+			}
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+
+		params.NextToken = output.NextToken
+	}
 
 	_, err := ts.svc.DeleteServer(&transfer.DeleteServerInput{
 		ServerId: ts.serverID,
@@ -88,6 +120,7 @@ func (ts *TransferServer) Properties() types.Properties {
 	properties.
 		Set("ServerID", ts.serverID).
 		Set("EndpointType", ts.endpointType).
+		Set("State", ts.state).
 		Set("Protocols", strings.Join(ts.protocols, ", "))
 	return properties
 }
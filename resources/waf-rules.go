@@ -12,7 +12,7 @@ type WAFRule struct {
 }
 
 func init() {
-	register("WAFRule", ListWAFRules)
+	registerGlobal("WAFRule", ListWAFRules)
 }
 
 func ListWAFRules(sess *session.Session) ([]Resource, error) {
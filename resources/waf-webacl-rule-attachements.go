@@ -15,7 +15,7 @@ type WAFWebACLRuleAttachment struct {
 }
 
 func init() {
-	register("WAFWebACLRuleAttachment", ListWAFWebACLRuleAttachments)
+	registerGlobal("WAFWebACLRuleAttachment", ListWAFWebACLRuleAttachments)
 }
 
 func ListWAFWebACLRuleAttachments(sess *session.Session) ([]Resource, error) {
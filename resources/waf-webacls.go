@@ -12,7 +12,7 @@ type WAFWebACL struct {
 }
 
 func init() {
-	register("WAFWebACL", ListWAFWebACLs)
+	registerGlobal("WAFWebACL", ListWAFWebACLs)
 }
 
 func ListWAFWebACLs(sess *session.Session) ([]Resource, error) {
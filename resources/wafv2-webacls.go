@@ -10,6 +10,7 @@ import (
 type WAFv2WebACL struct {
 	svc       *wafv2.WAFV2
 	ID        *string
+	arn       *string
 	name      *string
 	lockToken *string
 	scope     *string
@@ -61,6 +62,7 @@ func getWebACLs(svc *wafv2.WAFV2, params *wafv2.ListWebACLsInput) ([]Resource, e
 			resources = append(resources, &WAFv2WebACL{
 				svc:       svc,
 				ID:        webACL.Id,
+				arn:       webACL.ARN,
 				name:      webACL.Name,
 				lockToken: webACL.LockToken,
 				scope:     params.Scope,
@@ -76,7 +78,17 @@ func getWebACLs(svc *wafv2.WAFV2, params *wafv2.ListWebACLsInput) ([]Resource, e
 	return resources, nil
 }
 
+// Remove disassociates regional resources before deleting the web ACL.
+// CloudFront distributions reference the web ACL from their own config, so
+// the deletion fails until the CloudFrontDistribution is gone and is retried.
 func (f *WAFv2WebACL) Remove() error {
+	if *f.scope == "REGIONAL" {
+		err := f.disassociate()
+		if err != nil {
+			return err
+		}
+	}
+
 	_, err := f.svc.DeleteWebACL(&wafv2.DeleteWebACLInput{
 		Id:        f.ID,
 		Name:      f.name,
@@ -87,6 +99,29 @@ func (f *WAFv2WebACL) Remove() error {
 	return err
 }
 
+func (f *WAFv2WebACL) disassociate() error {
+	for _, resourceType := range wafv2.ResourceType_Values() {
+		resp, err := f.svc.ListResourcesForWebACL(&wafv2.ListResourcesForWebACLInput{
+			WebACLArn:    f.arn,
+			ResourceType: aws.String(resourceType),
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, resourceArn := range resp.ResourceArns {
+			_, err := f.svc.DisassociateWebACL(&wafv2.DisassociateWebACLInput{
+				ResourceArn: resourceArn,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (f *WAFv2WebACL) String() string {
 	return *f.ID
 }
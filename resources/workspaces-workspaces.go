@@ -1,14 +1,21 @@
 package resources
 
 import (
+	"fmt"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/workspaces"
+	"github.com/rebuy-de/aws-nuke/pkg/types"
 )
 
 type WorkSpacesWorkspace struct {
 	svc         *workspaces.WorkSpaces
 	workspaceID *string
+	state       *string
+	userName    *string
+	directoryID *string
+	bundleID    *string
 }
 
 func init() {
@@ -33,6 +40,10 @@ func ListWorkSpacesWorkspaces(sess *session.Session) ([]Resource, error) {
 			resources = append(resources, &WorkSpacesWorkspace{
 				svc:         svc,
 				workspaceID: workspace.WorkspaceId,
+				state:       workspace.State,
+				userName:    workspace.UserName,
+				directoryID: workspace.DirectoryId,
+				bundleID:    workspace.BundleId,
 			})
 		}
 
@@ -46,7 +57,18 @@ func ListWorkSpacesWorkspaces(sess *session.Session) ([]Resource, error) {
 	return resources, nil
 }
 
+func (f *WorkSpacesWorkspace) Filter() error {
+	if aws.StringValue(f.state) == workspaces.WorkspaceStateTerminated {
+		return fmt.Errorf("already terminated")
+	}
+
+	return nil
+}
+
 func (f *WorkSpacesWorkspace) Remove() error {
+	if aws.StringValue(f.state) == workspaces.WorkspaceStateTerminating {
+		return nil
+	}
 
 	stopRequest := &workspaces.StopRequest{
 		WorkspaceId: f.workspaceID,
@@ -67,6 +89,15 @@ func (f *WorkSpacesWorkspace) Remove() error {
 	return err
 }
 
+func (f *WorkSpacesWorkspace) Properties() types.Properties {
+	return types.NewProperties().
+		Set("ID", f.workspaceID).
+		Set("State", f.state).
+		Set("UserName", f.userName).
+		Set("DirectoryID", f.directoryID).
+		Set("BundleID", f.bundleID)
+}
+
 func (f *WorkSpacesWorkspace) String() string {
 	return *f.workspaceID
 }